@@ -1,33 +1,131 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"fmt"
 	"log"
+	"log/slog"
+	"os"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
 	"github.com/IamSBStakumi/mysterio_backend/internal/handler"
+	"github.com/IamSBStakumi/mysterio_backend/internal/repository"
 	"github.com/IamSBStakumi/mysterio_backend/internal/service"
 )
 
 func main(){
 	e := echo.New()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
 
-	e.Use(middleware.RequestLogger())
+	e.Use(handler.RequestLogger(slog.Default()))
 	e.Use(middleware.Recover())
 
-	scenarioS, err := service.NewScenarioService()
+	gen, err := newScenarioGenerator()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	sessionS := service.NewSessionService(scenarioS)
+	repo, err := newRepository()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scenarioS, err := service.NewScenarioService(gen, repo)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if v := os.Getenv("SCENARIO_MAX_REPAIR_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid SCENARIO_MAX_REPAIR_ATTEMPTS: %v", err)
+		}
+		if n < 0 {
+			log.Fatalf("invalid SCENARIO_MAX_REPAIR_ATTEMPTS: %d is negative", n)
+		}
+		scenarioS.MaxRepairAttempts = n
+	}
+
+	sessionS := service.NewSessionService(repo, scenarioS)
+	if err := sessionS.RestoreTimers(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+
+	signer, err := newSigner()
+	if err != nil {
+		log.Fatal(err)
+	}
+	e.Use(auth.Middleware(signer))
 
 	server := &handler.Server{
-		SessionS: sessionS,
+		SessionS:  sessionS,
+		ScenarioS: scenarioS,
+		Auth:      signer,
+		Operator:  newOperator(),
 	}
 	api.RegisterHandlers(e, server)
 
 	e.Logger.Fatal(e.Start(":8080"))
 }
+
+// SCENARIO_GENERATOR でシナリオ生成バックエンドを切り替える (fixture | openai)
+func newScenarioGenerator() (generator.ScenarioGenerator, error) {
+	switch os.Getenv("SCENARIO_GENERATOR") {
+	case "", "fixture":
+		return generator.NewFixtureGenerator(), nil
+	case "openai":
+		return generator.NewOpenAIGenerator(generator.OpenAIConfig{
+			BaseURL:    os.Getenv("OPENAI_BASE_URL"),
+			APIKey:     os.Getenv("OPENAI_API_KEY"),
+			Model:      os.Getenv("OPENAI_MODEL"),
+			SchemaPath: service.SCHEMA_PATH,
+		})
+	default:
+		return nil, fmt.Errorf("unknown SCENARIO_GENERATOR: %s", os.Getenv("SCENARIO_GENERATOR"))
+	}
+}
+
+// SESSION_STORE でセッションとシナリオライブラリの保存先を切り替える (memory | sqlite)
+func newRepository() (repository.Repository, error) {
+	switch os.Getenv("SESSION_STORE") {
+	case "", "memory":
+		return repository.NewMemoryRepository(), nil
+	case "sqlite":
+		path := os.Getenv("SQLITE_PATH")
+		if path == "" {
+			path = "mysterio.db"
+		}
+		return repository.NewSQLiteRepository(path)
+	default:
+		return nil, fmt.Errorf("unknown SESSION_STORE: %s", os.Getenv("SESSION_STORE"))
+	}
+}
+
+// OPERATOR_KEY はシナリオの登録など管理用エンドポイントの鍵。未設定ならそれらは使えない
+func newOperator() *auth.Operator {
+	key := os.Getenv("OPERATOR_KEY")
+	if key == "" {
+		log.Print("OPERATOR_KEY is not set; operator endpoints are disabled")
+	}
+	return auth.NewOperator(key)
+}
+
+// AUTH_SECRET が未設定の場合は起動ごとに乱数を使う。再起動すると発行済みトークンは無効になる
+func newSigner() (*auth.Signer, error) {
+	if secret := os.Getenv("AUTH_SECRET"); secret != "" {
+		return auth.NewSigner([]byte(secret)), nil
+	}
+
+	log.Print("AUTH_SECRET is not set; issued tokens will not survive a restart")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate auth secret: %w", err)
+	}
+	return auth.NewSigner(secret), nil
+}
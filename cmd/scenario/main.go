@@ -0,0 +1,276 @@
+// scenario は手書きのシナリオJSONを検証・チェック・冊子化するコマンド
+//
+//	scenario validate [-schema path] file
+//	scenario lint     [-schema path] [-disable rule,...] file
+//	scenario render   [-schema path] [-role p1|gm] [-out dir] file
+//
+// file に - を渡すと標準入力から読む。-schema を省くとバイナリに埋め込んだスキーマを使う
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/booklet"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/schema"
+	"github.com/IamSBStakumi/mysterio_backend/internal/service"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// 終了コード。スクリプトから結果を判定できるようにする
+const (
+	EXIT_OK       = 0
+	EXIT_INVALID  = 1
+	EXIT_USAGE    = 2
+	EXIT_WARNINGS = 3
+)
+
+const usage = `usage: scenario <command> [flags] <file>
+
+commands:
+  validate  run the schema and semantic checks the server runs
+  lint      validate, then warn about short texts, unreferenced roles
+            and intro texts that name the culprit; -disable turns rules off
+  render    print Markdown booklets for the GM and each role
+
+exit codes:
+  0  ok
+  1  the scenario is invalid
+  2  bad usage or the file could not be read
+  3  lint found warnings
+`
+
+// errUsage は使い方の誤り。メッセージは出力済み
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return EXIT_USAGE
+	}
+
+	var code int
+	var err error
+	switch args[0] {
+	case "validate":
+		code, err = validate(args[1:], stdin, stdout, stderr)
+	case "lint":
+		code, err = lint(args[1:], stdin, stdout, stderr)
+	case "render":
+		code, err = render(args[1:], stdin, stdout, stderr)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(stdout, usage)
+		return EXIT_OK
+	default:
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usage)
+		return EXIT_USAGE
+	}
+
+	if errors.Is(err, errUsage) {
+		return EXIT_USAGE
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "scenario %s: %v\n", args[0], err)
+		return EXIT_USAGE
+	}
+	return code
+}
+
+// input はサブコマンド共通のフラグと、読み込んだシナリオ
+type input struct {
+	flags      *flag.FlagSet
+	schemaPath *string
+	name       string
+	data       []byte
+}
+
+func newFlags(command string, stderr io.Writer) *input {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	return &input{
+		flags:      flags,
+		schemaPath: flags.String("schema", "", "path to scenario.schema.json (default: the schema built into this binary)"),
+	}
+}
+
+// parse はフラグを解釈し、残りの引数1つをシナリオとして読み込む
+func (in *input) parse(args []string, stdin io.Reader) error {
+	if err := in.flags.Parse(args); err != nil {
+		return errUsage
+	}
+	if in.flags.NArg() != 1 {
+		fmt.Fprintf(in.flags.Output(), "usage: scenario %s [flags] <file>\n", in.flags.Name())
+		in.flags.PrintDefaults()
+		return errUsage
+	}
+
+	in.name = in.flags.Arg(0)
+	var err error
+	if in.name == "-" {
+		in.name = "<stdin>"
+		in.data, err = io.ReadAll(stdin)
+	} else {
+		in.data, err = os.ReadFile(in.name)
+	}
+	return err
+}
+
+// check はサーバーと同じ検証をかけ、違反を書き出す。違反があれば nil を返す
+func (in *input) check(stdout io.Writer) (*domain.Scenario, error) {
+	var scenarioSchema *jsonschema.Schema
+	var err error
+	if *in.schemaPath == "" {
+		scenarioSchema, err = service.CompileScenarioSchema(service.SCHEMA_PATH, schema.ScenarioJSON)
+	} else {
+		scenarioSchema, err = service.LoadScenarioSchema(*in.schemaPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	validator := &service.ScenarioService{Schema: scenarioSchema}
+
+	scenario, violations, err := validator.Validate(in.data)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range violations {
+		fmt.Fprintf(stdout, "%s:%s: error: %s [%s]\n", in.name, pathOrRoot(v.InstancePath), v.Message, v.Keyword)
+	}
+	return scenario, nil
+}
+
+func validate(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	in := newFlags("validate", stderr)
+	if err := in.parse(args, stdin); err != nil {
+		return 0, err
+	}
+
+	scenario, err := in.check(stdout)
+	if err != nil {
+		return 0, err
+	}
+	if scenario == nil {
+		return EXIT_INVALID, nil
+	}
+	fmt.Fprintf(stdout, "%s: ok\n", in.name)
+	return EXIT_OK, nil
+}
+
+func lint(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	in := newFlags("lint", stderr)
+	rules := service.LintRules()
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, string(rule))
+	}
+	disable := in.flags.String("disable", "", "comma separated rules to skip: "+strings.Join(names, ", "))
+	if err := in.parse(args, stdin); err != nil {
+		return 0, err
+	}
+
+	var disabled []service.IssueCode
+	for _, name := range strings.Split(*disable, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if !slices.Contains(rules, service.IssueCode(name)) {
+			fmt.Fprintf(stderr, "unknown lint rule %q\n", name)
+			return 0, errUsage
+		}
+		disabled = append(disabled, service.IssueCode(name))
+	}
+
+	scenario, err := in.check(stdout)
+	if err != nil {
+		return 0, err
+	}
+	if scenario == nil {
+		return EXIT_INVALID, nil
+	}
+
+	issues := service.LintScenario(scenario, disabled...)
+	for _, issue := range issues {
+		fmt.Fprintf(stdout, "%s:%s: warning: %s [%s]\n", in.name, pathOrRoot(issue.Path), issue.Message, issue.Code)
+	}
+	if len(issues) > 0 {
+		return EXIT_WARNINGS, nil
+	}
+	fmt.Fprintf(stdout, "%s: ok\n", in.name)
+	return EXIT_OK, nil
+}
+
+func render(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	in := newFlags("render", stderr)
+	role := in.flags.String("role", "", "render only this role's booklet, or gm for the GM book")
+	outDir := in.flags.String("out", "", "write gm.md and <roleId>.md into this directory instead of stdout")
+	if err := in.parse(args, stdin); err != nil {
+		return 0, err
+	}
+
+	scenario, err := in.check(stdout)
+	if err != nil {
+		return 0, err
+	}
+	if scenario == nil {
+		return EXIT_INVALID, nil
+	}
+
+	var booklets []*booklet.Booklet
+	if *role == "" || *role == "gm" {
+		booklets = append(booklets, booklet.ForGM(scenario))
+	}
+	for _, c := range scenario.Characters {
+		if *role == "" || *role == c.ID {
+			b, _ := booklet.ForRole(scenario, c.ID)
+			booklets = append(booklets, b)
+		}
+	}
+	if len(booklets) == 0 {
+		fmt.Fprintf(stderr, "unknown role %q\n", *role)
+		return 0, errUsage
+	}
+
+	if *outDir == "" {
+		for i, b := range booklets {
+			if i > 0 {
+				fmt.Fprintln(stdout)
+			}
+			fmt.Fprint(stdout, booklet.Markdown(b))
+		}
+		return EXIT_OK, nil
+	}
+
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		return 0, err
+	}
+	for _, b := range booklets {
+		name := b.RoleID
+		if name == "" {
+			name = "gm"
+		}
+		path := filepath.Join(*outDir, name+".md")
+		if err := os.WriteFile(path, []byte(booklet.Markdown(b)), 0o644); err != nil {
+			return 0, err
+		}
+		fmt.Fprintln(stdout, path)
+	}
+	return EXIT_OK, nil
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
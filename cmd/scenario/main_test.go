@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+const fixturePath = "../../internal/generator/testdata/fixture_scenario.json"
+
+// shortGoal はフィクスチャの最初の個人目標を、スキーマは通るが lint で警告される長さにする
+func shortGoal(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scenario map[string]any
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		t.Fatal(err)
+	}
+	scenario["characters"].([]any)[0].(map[string]any)["personalGoal"] = "借金のことを誰にも知られないまま、この夜を乗り切ること。"
+	out, err := json.Marshal(scenario)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestRunExitCodes(t *testing.T) {
+	warned := shortGoal(t)
+
+	tests := []struct {
+		name  string
+		args  []string
+		stdin string
+		want  int
+		// 標準出力に含まれるはずの文字列
+		stdout string
+	}{
+		{name: "validate ok", args: []string{"validate", fixturePath}, want: EXIT_OK, stdout: ": ok"},
+		{name: "lint ok", args: []string{"lint", fixturePath}, want: EXIT_OK, stdout: ": ok"},
+		{name: "render", args: []string{"render", "-role", "gm", fixturePath}, want: EXIT_OK},
+		{name: "invalid scenario", args: []string{"validate", "-"}, stdin: `{}`, want: EXIT_INVALID, stdout: "error:"},
+		{name: "broken json", args: []string{"validate", "-"}, stdin: `{`, want: EXIT_INVALID},
+		{name: "lint of an invalid scenario", args: []string{"lint", "-"}, stdin: `{"meta":{}}`, want: EXIT_INVALID},
+		{name: "no command", args: nil, want: EXIT_USAGE},
+		{name: "unknown command", args: []string{"publish", fixturePath}, want: EXIT_USAGE},
+		{name: "missing file", args: []string{"validate"}, want: EXIT_USAGE},
+		{name: "unreadable file", args: []string{"validate", "does-not-exist.json"}, want: EXIT_USAGE},
+		{name: "unknown lint rule", args: []string{"lint", "-disable", "bogus", fixturePath}, want: EXIT_USAGE},
+		{name: "unknown role", args: []string{"render", "-role", "p9", fixturePath}, want: EXIT_USAGE},
+		{name: "lint warnings", args: []string{"lint", "-"}, stdin: warned, want: EXIT_WARNINGS, stdout: "[short_text]"},
+		{name: "disabled lint rule", args: []string{"lint", "-disable", "short_text", "-"}, stdin: warned, want: EXIT_OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			got := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if got != tt.want {
+				t.Fatalf("exit code = %d, want %d\nstdout: %s\nstderr: %s", got, tt.want, stdout.String(), stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.stdout) {
+				t.Errorf("stdout = %q, want it to contain %q", stdout.String(), tt.stdout)
+			}
+		})
+	}
+}
@@ -15,12 +15,20 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	HostTokenScopes   = "hostToken.Scopes"
+	OperatorKeyScopes = "operatorKey.Scopes"
+	PlayerTokenScopes = "playerToken.Scopes"
+	QueryTokenScopes  = "queryToken.Scopes"
+)
+
 // Defines values for AdvancePhaseResponsePhase.
 const (
 	AdvancePhaseResponsePhaseDiscussion     AdvancePhaseResponsePhase = "discussion"
@@ -28,9 +36,43 @@ const (
 	AdvancePhaseResponsePhaseIntro          AdvancePhaseResponsePhase = "intro"
 	AdvancePhaseResponsePhaseInvestigation1 AdvancePhaseResponsePhase = "investigation1"
 	AdvancePhaseResponsePhaseInvestigation2 AdvancePhaseResponsePhase = "investigation2"
+	AdvancePhaseResponsePhaseLobby          AdvancePhaseResponsePhase = "lobby"
 	AdvancePhaseResponsePhaseVoting         AdvancePhaseResponsePhase = "voting"
 )
 
+// Defines values for ClueCardPhase.
+const (
+	ClueCardPhaseInvestigation1 ClueCardPhase = "investigation1"
+	ClueCardPhaseInvestigation2 ClueCardPhase = "investigation2"
+)
+
+// Defines values for ClueCardTargetType.
+const (
+	ClueCardTargetTypeCharacter ClueCardTargetType = "character"
+	ClueCardTargetTypeLocation  ClueCardTargetType = "location"
+)
+
+// Defines values for ClueOfferStatus.
+const (
+	Accepted  ClueOfferStatus = "accepted"
+	Declined  ClueOfferStatus = "declined"
+	Pending   ClueOfferStatus = "pending"
+	Withdrawn ClueOfferStatus = "withdrawn"
+)
+
+// Defines values for ClueTargetTargetType.
+const (
+	ClueTargetTargetTypeCharacter ClueTargetTargetType = "character"
+	ClueTargetTargetTypeLocation  ClueTargetTargetType = "location"
+)
+
+// Defines values for CreateSessionRequestContentRestrictions.
+const (
+	NoGore          CreateSessionRequestContentRestrictions = "no_gore"
+	NoSelfHarm      CreateSessionRequestContentRestrictions = "no_self_harm"
+	NoSexualContent CreateSessionRequestContentRestrictions = "no_sexual_content"
+)
+
 // Defines values for CreateSessionRequestDifficulty.
 const (
 	Easy   CreateSessionRequestDifficulty = "easy"
@@ -44,6 +86,50 @@ const (
 	N5 CreateSessionRequestPlayerCount = 5
 )
 
+// Defines values for CreateSessionRequestRoleAssignment.
+const (
+	CreateSessionRequestRoleAssignmentHost      CreateSessionRequestRoleAssignment = "host"
+	CreateSessionRequestRoleAssignmentJoinOrder CreateSessionRequestRoleAssignment = "join_order"
+	CreateSessionRequestRoleAssignmentShuffle   CreateSessionRequestRoleAssignment = "shuffle"
+)
+
+// Defines values for CreateSessionRequestTieBreak.
+const (
+	CreateSessionRequestTieBreakCulpritEscapes CreateSessionRequestTieBreak = "culprit_escapes"
+	CreateSessionRequestTieBreakGm             CreateSessionRequestTieBreak = "gm"
+	CreateSessionRequestTieBreakRevote         CreateSessionRequestTieBreak = "revote"
+)
+
+// Defines values for CreateSessionRequestTone.
+const (
+	Comedic CreateSessionRequestTone = "comedic"
+	Serious CreateSessionRequestTone = "serious"
+)
+
+// Defines values for InvestigateRequestTargetType.
+const (
+	Character InvestigateRequestTargetType = "character"
+	Location  InvestigateRequestTargetType = "location"
+)
+
+// Defines values for LobbyResponsePhase.
+const (
+	LobbyResponsePhaseDiscussion     LobbyResponsePhase = "discussion"
+	LobbyResponsePhaseEnding         LobbyResponsePhase = "ending"
+	LobbyResponsePhaseIntro          LobbyResponsePhase = "intro"
+	LobbyResponsePhaseInvestigation1 LobbyResponsePhase = "investigation1"
+	LobbyResponsePhaseInvestigation2 LobbyResponsePhase = "investigation2"
+	LobbyResponsePhaseLobby          LobbyResponsePhase = "lobby"
+	LobbyResponsePhaseVoting         LobbyResponsePhase = "voting"
+)
+
+// Defines values for LobbyResponseRoleAssignment.
+const (
+	LobbyResponseRoleAssignmentHost      LobbyResponseRoleAssignment = "host"
+	LobbyResponseRoleAssignmentJoinOrder LobbyResponseRoleAssignment = "join_order"
+	LobbyResponseRoleAssignmentShuffle   LobbyResponseRoleAssignment = "shuffle"
+)
+
 // Defines values for PhaseResponsePhase.
 const (
 	PhaseResponsePhaseDiscussion     PhaseResponsePhase = "discussion"
@@ -51,9 +137,92 @@ const (
 	PhaseResponsePhaseIntro          PhaseResponsePhase = "intro"
 	PhaseResponsePhaseInvestigation1 PhaseResponsePhase = "investigation1"
 	PhaseResponsePhaseInvestigation2 PhaseResponsePhase = "investigation2"
+	PhaseResponsePhaseLobby          PhaseResponsePhase = "lobby"
 	PhaseResponsePhaseVoting         PhaseResponsePhase = "voting"
 )
 
+// Defines values for ProblemCode.
+const (
+	ProblemCodeActionPointsExhausted    ProblemCode = "action_points_exhausted"
+	ProblemCodeClueNotFound             ProblemCode = "clue_not_found"
+	ProblemCodeClueNotTransferable      ProblemCode = "clue_not_transferable"
+	ProblemCodeForbidden                ProblemCode = "forbidden"
+	ProblemCodeInternalError            ProblemCode = "internal_error"
+	ProblemCodeInvalidPhaseTransition   ProblemCode = "invalid_phase_transition"
+	ProblemCodeInvalidRequest           ProblemCode = "invalid_request"
+	ProblemCodeInvalidScenario          ProblemCode = "invalid_scenario"
+	ProblemCodeLobbyNotReady            ProblemCode = "lobby_not_ready"
+	ProblemCodeMethodNotAllowed         ProblemCode = "method_not_allowed"
+	ProblemCodeNoCluesLeft              ProblemCode = "no_clues_left"
+	ProblemCodeNotFound                 ProblemCode = "not_found"
+	ProblemCodeOfferClosed              ProblemCode = "offer_closed"
+	ProblemCodeOfferNotFound            ProblemCode = "offer_not_found"
+	ProblemCodeOfferPending             ProblemCode = "offer_pending"
+	ProblemCodePlayerNotFound           ProblemCode = "player_not_found"
+	ProblemCodeScenarioGenerationFailed ProblemCode = "scenario_generation_failed"
+	ProblemCodeScenarioNotFound         ProblemCode = "scenario_not_found"
+	ProblemCodeSessionFull              ProblemCode = "session_full"
+	ProblemCodeSessionNotFound          ProblemCode = "session_not_found"
+	ProblemCodeTimerState               ProblemCode = "timer_state"
+	ProblemCodeUnauthorized             ProblemCode = "unauthorized"
+	ProblemCodeVoteTied                 ProblemCode = "vote_tied"
+	ProblemCodeVotesMissing             ProblemCode = "votes_missing"
+	ProblemCodeWrongPhase               ProblemCode = "wrong_phase"
+)
+
+// Defines values for ScenarioResponseSource.
+const (
+	ScenarioResponseSourceGenerated ScenarioResponseSource = "generated"
+	ScenarioResponseSourceUploaded  ScenarioResponseSource = "uploaded"
+)
+
+// Defines values for ScenarioSummarySource.
+const (
+	ScenarioSummarySourceGenerated ScenarioSummarySource = "generated"
+	ScenarioSummarySourceUploaded  ScenarioSummarySource = "uploaded"
+)
+
+// Defines values for TimerResponseState.
+const (
+	Expired TimerResponseState = "expired"
+	None    TimerResponseState = "none"
+	Paused  TimerResponseState = "paused"
+	Running TimerResponseState = "running"
+)
+
+// Defines values for TransitionKind.
+const (
+	Advance TransitionKind = "advance"
+	Rewind  TransitionKind = "rewind"
+	Start   TransitionKind = "start"
+	Timer   TransitionKind = "timer"
+)
+
+// Defines values for VoteResultDecision.
+const (
+	VoteResultDecisionCulpritEscapes VoteResultDecision = "culprit_escapes"
+	VoteResultDecisionGm             VoteResultDecision = "gm"
+	VoteResultDecisionMajority       VoteResultDecision = "majority"
+	VoteResultDecisionNoVotes        VoteResultDecision = "no_votes"
+)
+
+// Defines values for WhisperPhasesPhases.
+const (
+	Discussion     WhisperPhasesPhases = "discussion"
+	Ending         WhisperPhasesPhases = "ending"
+	Intro          WhisperPhasesPhases = "intro"
+	Investigation1 WhisperPhasesPhases = "investigation1"
+	Investigation2 WhisperPhasesPhases = "investigation2"
+	Lobby          WhisperPhasesPhases = "lobby"
+	Voting         WhisperPhasesPhases = "voting"
+)
+
+// AdvancePhaseRequest defines model for AdvancePhaseRequest.
+type AdvancePhaseRequest struct {
+	// Force Leave the phase even if its guard is not met, such as missing votes
+	Force *bool `json:"force,omitempty"`
+}
+
 // AdvancePhaseResponse defines model for AdvancePhaseResponse.
 type AdvancePhaseResponse struct {
 	Phase AdvancePhaseResponsePhase `json:"phase"`
@@ -62,20 +231,216 @@ type AdvancePhaseResponse struct {
 // AdvancePhaseResponsePhase defines model for AdvancePhaseResponse.Phase.
 type AdvancePhaseResponsePhase string
 
+// AssignedPlayer defines model for AssignedPlayer.
+type AssignedPlayer struct {
+	PlayerId   string `json:"playerId"`
+	PlayerName string `json:"playerName"`
+
+	// Role Public part of a character, safe to show to everyone
+	Role RoleSummary `json:"role"`
+}
+
+// CastVoteRequest defines model for CastVoteRequest.
+type CastVoteRequest struct {
+	AccusedRoleId string `json:"accusedRoleId"`
+}
+
+// ClueCard defines model for ClueCard.
+type ClueCard struct {
+	// History Who held the card since when, oldest first. Only returned to the host.
+	History *[]ClueHolding `json:"history,omitempty"`
+
+	// HolderId Player holding the card
+	HolderId        string             `json:"holderId"`
+	Id              string             `json:"id"`
+	NonTransferable bool               `json:"nonTransferable"`
+	Phase           ClueCardPhase      `json:"phase"`
+	Revealed        bool               `json:"revealed"`
+	Target          string             `json:"target"`
+	TargetType      ClueCardTargetType `json:"targetType"`
+	Text            string             `json:"text"`
+	Title           string             `json:"title"`
+}
+
+// ClueCardPhase defines model for ClueCard.Phase.
+type ClueCardPhase string
+
+// ClueCardTargetType defines model for ClueCard.TargetType.
+type ClueCardTargetType string
+
+// ClueHolding defines model for ClueHolding.
+type ClueHolding struct {
+	PlayerId string    `json:"playerId"`
+	Since    time.Time `json:"since"`
+}
+
+// ClueListResponse defines model for ClueListResponse.
+type ClueListResponse struct {
+	Clues []ClueCard `json:"clues"`
+}
+
+// ClueOffer defines model for ClueOffer.
+type ClueOffer struct {
+	ClueId       string          `json:"clueId"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	FromPlayerId string          `json:"fromPlayerId"`
+	OfferId      string          `json:"offerId"`
+	ResolvedAt   *time.Time      `json:"resolvedAt"`
+	Status       ClueOfferStatus `json:"status"`
+	ToPlayerId   string          `json:"toPlayerId"`
+}
+
+// ClueOfferStatus defines model for ClueOffer.Status.
+type ClueOfferStatus string
+
+// ClueOfferListResponse defines model for ClueOfferListResponse.
+type ClueOfferListResponse struct {
+	Offers []ClueOffer `json:"offers"`
+}
+
+// ClueOfferRequest defines model for ClueOfferRequest.
+type ClueOfferRequest struct {
+	ToPlayerId string `json:"toPlayerId"`
+}
+
+// ClueTarget defines model for ClueTarget.
+type ClueTarget struct {
+	// Remaining Clue cards not drawn yet at this target
+	Remaining int `json:"remaining"`
+
+	// Target Location name, or character ID for character targets
+	Target     string               `json:"target"`
+	TargetType ClueTargetTargetType `json:"targetType"`
+}
+
+// ClueTargetTargetType defines model for ClueTarget.TargetType.
+type ClueTargetTargetType string
+
 // CreateSessionRequest defines model for CreateSessionRequest.
 type CreateSessionRequest struct {
-	Difficulty  CreateSessionRequestDifficulty  `json:"difficulty"`
+	// ContentRestrictions Content the scenario must leave out
+	ContentRestrictions *[]CreateSessionRequestContentRestrictions `json:"contentRestrictions,omitempty"`
+	Difficulty          CreateSessionRequestDifficulty             `json:"difficulty"`
+	Era                 *string                                    `json:"era,omitempty"`
+
+	// Language BCP 47 tag of the language all texts are written in
+	Language    *string                         `json:"language,omitempty"`
 	PlayerCount CreateSessionRequestPlayerCount `json:"playerCount"`
+
+	// RoleAssignment How roles are handed out. join_order assigns them as players join, shuffle and host assign them when the host deals roles.
+	RoleAssignment *CreateSessionRequestRoleAssignment `json:"roleAssignment,omitempty"`
+
+	// ScenarioId Replay a saved scenario instead of generating a new one. playerCount, difficulty and any generation fields below must match the scenario's meta, otherwise the request is rejected.
+	ScenarioId *string `json:"scenarioId,omitempty"`
+
+	// Theme Where the mystery takes place
+	Theme *string `json:"theme,omitempty"`
+
+	// TieBreak How a tied vote is settled. revote clears the votes and votes again, up to three rounds in total after which the culprit escapes, gm lets the host pick among the tied roles and culprit_escapes accuses nobody.
+	TieBreak *CreateSessionRequestTieBreak `json:"tieBreak,omitempty"`
+
+	// Timer Phase time budgets. Phases left out get a share of the scenario's estimated play time; 0 turns the timer off for that phase. lobby and ending have no timer and are rejected.
+	Timer *TimerSettings            `json:"timer,omitempty"`
+	Tone  *CreateSessionRequestTone `json:"tone,omitempty"`
 }
 
+// CreateSessionRequestContentRestrictions defines model for CreateSessionRequest.ContentRestrictions.
+type CreateSessionRequestContentRestrictions string
+
 // CreateSessionRequestDifficulty defines model for CreateSessionRequest.Difficulty.
 type CreateSessionRequestDifficulty string
 
 // CreateSessionRequestPlayerCount defines model for CreateSessionRequest.PlayerCount.
 type CreateSessionRequestPlayerCount int
 
+// CreateSessionRequestRoleAssignment How roles are handed out. join_order assigns them as players join, shuffle and host assign them when the host deals roles.
+type CreateSessionRequestRoleAssignment string
+
+// CreateSessionRequestTieBreak How a tied vote is settled. revote clears the votes and votes again, up to three rounds in total after which the culprit escapes, gm lets the host pick among the tied roles and culprit_escapes accuses nobody.
+type CreateSessionRequestTieBreak string
+
+// CreateSessionRequestTone defines model for CreateSessionRequest.Tone.
+type CreateSessionRequestTone string
+
 // CreateSessionResponse defines model for CreateSessionResponse.
 type CreateSessionResponse struct {
+	HostId string `json:"hostId"`
+
+	// HostToken Bearer token with GM rights such as advancing the phase
+	HostToken string `json:"hostToken"`
+	JoinCode  string `json:"joinCode"`
+	SessionId string `json:"sessionId"`
+}
+
+// DealRolesRequest defines model for DealRolesRequest.
+type DealRolesRequest struct {
+	// Assignments playerId to roleId. Required when the session uses host assignment.
+	Assignments *map[string]string `json:"assignments,omitempty"`
+}
+
+// DealRolesResponse defines model for DealRolesResponse.
+type DealRolesResponse struct {
+	Players []AssignedPlayer `json:"players"`
+}
+
+// EndingResponse defines model for EndingResponse.
+type EndingResponse struct {
+	GmText  string          `json:"gmText"`
+	Players []PlayerOutcome `json:"players"`
+	Truth   Truth           `json:"truth"`
+	Vote    *VoteResult     `json:"vote"`
+}
+
+// ExtendTimerRequest defines model for ExtendTimerRequest.
+type ExtendTimerRequest struct {
+	Seconds int `json:"seconds"`
+}
+
+// GenerationAttempt defines model for GenerationAttempt.
+type GenerationAttempt struct {
+	// Attempt 0 for the first try, then one per repair
+	Attempt int `json:"attempt"`
+
+	// Error Set when the generator itself failed
+	Error      *string     `json:"error,omitempty"`
+	Violations []Violation `json:"violations"`
+}
+
+// GenerationHistoryResponse defines model for GenerationHistoryResponse.
+type GenerationHistoryResponse struct {
+	Records []GenerationRecord `json:"records"`
+
+	// Repaired Calls that succeeded only after at least one repair
+	Repaired  int `json:"repaired"`
+	Succeeded int `json:"succeeded"`
+	Total     int `json:"total"`
+}
+
+// GenerationRecord defines model for GenerationRecord.
+type GenerationRecord struct {
+	Attempts  []GenerationAttempt `json:"attempts"`
+	StartedAt time.Time           `json:"startedAt"`
+	Succeeded bool                `json:"succeeded"`
+}
+
+// InvestigateRequest defines model for InvestigateRequest.
+type InvestigateRequest struct {
+	Target     string                       `json:"target"`
+	TargetType InvestigateRequestTargetType `json:"targetType"`
+}
+
+// InvestigateRequestTargetType defines model for InvestigateRequest.TargetType.
+type InvestigateRequestTargetType string
+
+// InvestigateResponse defines model for InvestigateResponse.
+type InvestigateResponse struct {
+	ActionPointsLeft int      `json:"actionPointsLeft"`
+	Clue             ClueCard `json:"clue"`
+}
+
+// JoinCodeResponse defines model for JoinCodeResponse.
+type JoinCodeResponse struct {
+	JoinCode  string `json:"joinCode"`
 	SessionId string `json:"sessionId"`
 }
 
@@ -87,31 +452,364 @@ type JoinPlayerRequest struct {
 // JoinPlayerResponse defines model for JoinPlayerResponse.
 type JoinPlayerResponse struct {
 	PlayerId string `json:"playerId"`
+
+	// Role Public part of a character, safe to show to everyone
+	Role *RoleSummary `json:"role,omitempty"`
+
+	// RoleId Null until the host deals roles when the session does not assign them on join.
+	RoleId *string `json:"roleId"`
+
+	// Token Bearer token identifying this player in the session
+	Token string `json:"token"`
+}
+
+// JudgeGoalRequest defines model for JudgeGoalRequest.
+type JudgeGoalRequest struct {
+	Achieved bool   `json:"achieved"`
 	RoleId   string `json:"roleId"`
 }
 
+// Knowledge defines model for Knowledge.
+type Knowledge struct {
+	AboutRoleId string `json:"aboutRoleId"`
+
+	// Phase Phase the information became visible in
+	Phase string `json:"phase"`
+	Text  string `json:"text"`
+}
+
+// LobbyPlayer defines model for LobbyPlayer.
+type LobbyPlayer struct {
+	IsHost     bool   `json:"isHost"`
+	PlayerId   string `json:"playerId"`
+	PlayerName string `json:"playerName"`
+	Ready      bool   `json:"ready"`
+
+	// Role Public part of a character, safe to show to everyone
+	Role *RoleSummary `json:"role,omitempty"`
+}
+
+// LobbyResponse defines model for LobbyResponse.
+type LobbyResponse struct {
+	HostId    string             `json:"hostId"`
+	JoinCode  string             `json:"joinCode"`
+	OpenRoles []RoleSummary      `json:"openRoles"`
+	Phase     LobbyResponsePhase `json:"phase"`
+
+	// PlayerCount Number of players the scenario needs before the game can start
+	PlayerCount    int                         `json:"playerCount"`
+	Players        []LobbyPlayer               `json:"players"`
+	RoleAssignment LobbyResponseRoleAssignment `json:"roleAssignment"`
+	SessionId      string                      `json:"sessionId"`
+	Title          string                      `json:"title"`
+}
+
+// LobbyResponsePhase defines model for LobbyResponse.Phase.
+type LobbyResponsePhase string
+
+// LobbyResponseRoleAssignment defines model for LobbyResponse.RoleAssignment.
+type LobbyResponseRoleAssignment string
+
 // PhaseResponse defines model for PhaseResponse.
 type PhaseResponse struct {
-	GmText      string             `json:"gmText"`
-	Phase       PhaseResponsePhase `json:"phase"`
-	PrivateInfo *string            `json:"privateInfo"`
-	PublicInfo  *string            `json:"publicInfo"`
+	// ActionPointsLeft Action points the caller has left, in investigation phases only
+	ActionPointsLeft *int `json:"actionPointsLeft"`
+
+	// ClueTargets Targets that can be investigated in this phase
+	ClueTargets *[]ClueTarget `json:"clueTargets,omitempty"`
+	GmText      string        `json:"gmText"`
+
+	// Knowledge What the caller's role has learned about other roles up to the current phase
+	Knowledge *[]Knowledge `json:"knowledge,omitempty"`
+
+	// PersonalGoal The caller's own personal goal, after the game has started
+	PersonalGoal *string            `json:"personalGoal"`
+	Phase        PhaseResponsePhase `json:"phase"`
+	PrivateInfo  *string            `json:"privateInfo"`
+	PublicInfo   *string            `json:"publicInfo"`
+
+	// Role Public part of a character, safe to show to everyone
+	Role *RoleSummary `json:"role,omitempty"`
+
+	// Secret The caller's own secret, after the game has started
+	Secret *string `json:"secret"`
 }
 
 // PhaseResponsePhase defines model for PhaseResponse.Phase.
 type PhaseResponsePhase string
 
-// GetSessionPhaseParams defines parameters for GetSessionPhase.
-type GetSessionPhaseParams struct {
-	XPlayerId string `json:"X-Player-Id"`
+// PlayerOutcome defines model for PlayerOutcome.
+type PlayerOutcome struct {
+	// Accused Whether the vote accused this player's role
+	Accused bool `json:"accused"`
+
+	// GoalAchieved Host's judgement of the personal goal, or null until judged
+	GoalAchieved *bool  `json:"goalAchieved"`
+	IsCulprit    bool   `json:"isCulprit"`
+	PersonalGoal string `json:"personalGoal"`
+	PlayerId     string `json:"playerId"`
+	PlayerName   string `json:"playerName"`
+
+	// Role Public part of a character, safe to show to everyone
+	Role   *RoleSummary `json:"role,omitempty"`
+	Secret string       `json:"secret"`
+
+	// TeamWon Result of the player's side, decided by the vote
+	TeamWon bool `json:"teamWon"`
+
+	// Won goalAchieved once judged, otherwise teamWon
+	Won bool `json:"won"`
+}
+
+// Problem RFC 7807 style error body
+type Problem struct {
+	// Code Machine readable error code to branch on
+	Code   ProblemCode `json:"code"`
+	Detail *string     `json:"detail,omitempty"`
+	Status int         `json:"status"`
+	Title  string      `json:"title"`
+	Type   string      `json:"type"`
+}
+
+// ProblemCode Machine readable error code to branch on
+type ProblemCode string
+
+// ReadyRequest defines model for ReadyRequest.
+type ReadyRequest struct {
+	Ready bool `json:"ready"`
+}
+
+// RoleSummary Public part of a character, safe to show to everyone
+type RoleSummary struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	PublicProfile string `json:"publicProfile"`
+}
+
+// ScenarioListResponse defines model for ScenarioListResponse.
+type ScenarioListResponse struct {
+	Scenarios []ScenarioSummary `json:"scenarios"`
+}
+
+// ScenarioResponse defines model for ScenarioResponse.
+type ScenarioResponse struct {
+	Characters           []RoleSummary `json:"characters"`
+	ContentRestrictions  *[]string     `json:"contentRestrictions,omitempty"`
+	CreatedAt            time.Time     `json:"createdAt"`
+	Difficulty           string        `json:"difficulty"`
+	Era                  *string       `json:"era,omitempty"`
+	EstimatedTimeMinutes int           `json:"estimatedTimeMinutes"`
+	Id                   string        `json:"id"`
+	IncidentDescription  string        `json:"incidentDescription"`
+	Language             *string       `json:"language,omitempty"`
+
+	// PlayCount Number of sessions started with this scenario
+	PlayCount        int                    `json:"playCount"`
+	PlayerCount      int                    `json:"playerCount"`
+	Source           ScenarioResponseSource `json:"source"`
+	Theme            *string                `json:"theme,omitempty"`
+	Title            string                 `json:"title"`
+	Tone             *string                `json:"tone,omitempty"`
+	WorldDescription string                 `json:"worldDescription"`
+}
+
+// ScenarioResponseSource defines model for ScenarioResponse.Source.
+type ScenarioResponseSource string
+
+// ScenarioSummary defines model for ScenarioSummary.
+type ScenarioSummary struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	Difficulty string    `json:"difficulty"`
+	Id         string    `json:"id"`
+
+	// PlayCount Number of sessions started with this scenario
+	PlayCount   int                   `json:"playCount"`
+	PlayerCount int                   `json:"playerCount"`
+	Source      ScenarioSummarySource `json:"source"`
+	Title       string                `json:"title"`
+}
+
+// ScenarioSummarySource defines model for ScenarioSummary.Source.
+type ScenarioSummarySource string
+
+// TimerResponse defines model for TimerResponse.
+type TimerResponse struct {
+	AutoAdvance bool `json:"autoAdvance"`
+
+	// DurationSeconds Time budget of the phase including extensions
+	DurationSeconds int `json:"durationSeconds"`
+
+	// EndsAt Set while the timer is running
+	EndsAt           *time.Time         `json:"endsAt"`
+	Phase            string             `json:"phase"`
+	RemainingSeconds int                `json:"remainingSeconds"`
+	State            TimerResponseState `json:"state"`
+	WarningSeconds   []int              `json:"warningSeconds"`
+}
+
+// TimerResponseState defines model for TimerResponse.State.
+type TimerResponseState string
+
+// TimerSettings Phase time budgets. Phases left out get a share of the scenario's estimated play time; 0 turns the timer off for that phase. lobby and ending have no timer and are rejected.
+type TimerSettings struct {
+	// AutoAdvance Advance to the next phase when the time runs out
+	AutoAdvance  *bool           `json:"autoAdvance,omitempty"`
+	PhaseMinutes *map[string]int `json:"phaseMinutes,omitempty"`
+
+	// WarningSeconds Remaining times that trigger a timer_warning event
+	WarningSeconds *[]int `json:"warningSeconds,omitempty"`
+}
+
+// TransferHostRequest defines model for TransferHostRequest.
+type TransferHostRequest struct {
+	PlayerId string `json:"playerId"`
+}
+
+// TransferHostResponse defines model for TransferHostResponse.
+type TransferHostResponse struct {
+	HostId string `json:"hostId"`
+}
+
+// Transition defines model for Transition.
+type Transition struct {
+	// ActorId Who made the transition; null for timer transitions
+	ActorId *string        `json:"actorId"`
+	At      time.Time      `json:"at"`
+	Forced  bool           `json:"forced"`
+	From    string         `json:"from"`
+	Kind    TransitionKind `json:"kind"`
+	To      string         `json:"to"`
+}
+
+// TransitionKind defines model for Transition.Kind.
+type TransitionKind string
+
+// TransitionListResponse defines model for TransitionListResponse.
+type TransitionListResponse struct {
+	Transitions []Transition `json:"transitions"`
+}
+
+// Truth defines model for Truth.
+type Truth struct {
+	CulpritId   string   `json:"culpritId"`
+	Method      string   `json:"method"`
+	Motive      string   `json:"motive"`
+	RedHerrings []string `json:"redHerrings"`
+	Timeline    string   `json:"timeline"`
+}
+
+// Violation defines model for Violation.
+type Violation struct {
+	InstancePath string `json:"instancePath"`
+	Keyword      string `json:"keyword"`
+	Message      string `json:"message"`
+}
+
+// VoteResult defines model for VoteResult.
+type VoteResult struct {
+	// AccusedRoleId Accused role, or null when the culprit escaped
+	AccusedRoleId *string `json:"accusedRoleId"`
+	CulpritId     string  `json:"culpritId"`
+
+	// Decision How the accused role was chosen. no_votes means nobody voted and the culprit escapes.
+	Decision   VoteResultDecision `json:"decision"`
+	PlayersWin bool               `json:"playersWin"`
+
+	// Round Voting round that settled the vote, counting revotes
+	Round int `json:"round"`
+
+	// Tally Vote count keyed by role ID
+	Tally map[string]int `json:"tally"`
+
+	// Votes Accused role ID keyed by player ID
+	Votes map[string]string `json:"votes"`
+}
+
+// VoteResultDecision How the accused role was chosen. no_votes means nobody voted and the culprit escapes.
+type VoteResultDecision string
+
+// WhisperPhases defines model for WhisperPhases.
+type WhisperPhases struct {
+	Phases []WhisperPhasesPhases `json:"phases"`
+}
+
+// WhisperPhasesPhases defines model for WhisperPhases.Phases.
+type WhisperPhasesPhases string
+
+// BadGateway RFC 7807 style error body
+type BadGateway = Problem
+
+// BadRequest RFC 7807 style error body
+type BadRequest = Problem
+
+// Conflict RFC 7807 style error body
+type Conflict = Problem
+
+// Forbidden RFC 7807 style error body
+type Forbidden = Problem
+
+// NotFound RFC 7807 style error body
+type NotFound = Problem
+
+// Unauthorized RFC 7807 style error body
+type Unauthorized = Problem
+
+// PostScenariosJSONBody defines parameters for PostScenarios.
+type PostScenariosJSONBody = map[string]interface{}
+
+// GetSessionChatParams defines parameters for GetSessionChat.
+type GetSessionChatParams struct {
+	// After Id of the last message the client already has
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+}
+
+// GetSessionEventsParams defines parameters for GetSessionEvents.
+type GetSessionEventsParams struct {
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
+// PostScenariosJSONRequestBody defines body for PostScenarios for application/json ContentType.
+type PostScenariosJSONRequestBody = PostScenariosJSONBody
+
 // PostSessionsJSONRequestBody defines body for PostSessions for application/json ContentType.
 type PostSessionsJSONRequestBody = CreateSessionRequest
 
+// PostSessionAdvanceJSONRequestBody defines body for PostSessionAdvance for application/json ContentType.
+type PostSessionAdvanceJSONRequestBody = AdvancePhaseRequest
+
+// PutSessionWhisperPhasesJSONRequestBody defines body for PutSessionWhisperPhases for application/json ContentType.
+type PutSessionWhisperPhasesJSONRequestBody = WhisperPhases
+
+// PostSessionClueOffersJSONRequestBody defines body for PostSessionClueOffers for application/json ContentType.
+type PostSessionClueOffersJSONRequestBody = ClueOfferRequest
+
+// PostSessionEndingGoalsJSONRequestBody defines body for PostSessionEndingGoals for application/json ContentType.
+type PostSessionEndingGoalsJSONRequestBody = JudgeGoalRequest
+
+// PostSessionHostJSONRequestBody defines body for PostSessionHost for application/json ContentType.
+type PostSessionHostJSONRequestBody = TransferHostRequest
+
+// PostSessionInvestigationsJSONRequestBody defines body for PostSessionInvestigations for application/json ContentType.
+type PostSessionInvestigationsJSONRequestBody = InvestigateRequest
+
 // PostSessionPlayersJSONRequestBody defines body for PostSessionPlayers for application/json ContentType.
 type PostSessionPlayersJSONRequestBody = JoinPlayerRequest
 
+// PostSessionReadyJSONRequestBody defines body for PostSessionReady for application/json ContentType.
+type PostSessionReadyJSONRequestBody = ReadyRequest
+
+// PostSessionRolesJSONRequestBody defines body for PostSessionRoles for application/json ContentType.
+type PostSessionRolesJSONRequestBody = DealRolesRequest
+
+// PostSessionTimerExtendJSONRequestBody defines body for PostSessionTimerExtend for application/json ContentType.
+type PostSessionTimerExtendJSONRequestBody = ExtendTimerRequest
+
+// PostSessionVotesJSONRequestBody defines body for PostSessionVotes for application/json ContentType.
+type PostSessionVotesJSONRequestBody = CastVoteRequest
+
+// PostSessionVoteDecisionJSONRequestBody defines body for PostSessionVoteDecision for application/json ContentType.
+type PostSessionVoteDecisionJSONRequestBody = CastVoteRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -185,37 +883,145 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJoinCode request
+	GetJoinCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScenarioGenerations request
+	GetScenarioGenerations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScenarios request
+	GetScenarios(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScenariosWithBody request with any body
+	PostScenariosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostScenarios(ctx context.Context, body PostScenariosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScenario request
+	GetScenario(ctx context.Context, scenarioId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSessionsWithBody request with any body
 	PostSessionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostSessions(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostSessionAdvance request
-	PostSessionAdvance(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetSession request
+	GetSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionAdvanceWithBody request with any body
+	PostSessionAdvanceWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionAdvance(ctx context.Context, sessionId string, body PostSessionAdvanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionChat request
+	GetSessionChat(ctx context.Context, sessionId string, params *GetSessionChatParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSessionWhisperPhasesWithBody request with any body
+	PutSessionWhisperPhasesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSessionWhisperPhases(ctx context.Context, sessionId string, body PutSessionWhisperPhasesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionClues request
+	GetSessionClues(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionClueOffersWithBody request with any body
+	PostSessionClueOffersWithBody(ctx context.Context, sessionId string, clueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionClueOffers(ctx context.Context, sessionId string, clueId string, body PostSessionClueOffersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionClueReveal request
+	PostSessionClueReveal(ctx context.Context, sessionId string, clueId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionEnding request
+	GetSessionEnding(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionEndingGoalsWithBody request with any body
+	PostSessionEndingGoalsWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionEndingGoals(ctx context.Context, sessionId string, body PostSessionEndingGoalsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionEvents request
+	GetSessionEvents(ctx context.Context, sessionId string, params *GetSessionEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionHandouts request
+	GetSessionHandouts(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionHostWithBody request with any body
+	PostSessionHostWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionHost(ctx context.Context, sessionId string, body PostSessionHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionInvestigationsWithBody request with any body
+	PostSessionInvestigationsWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionInvestigations(ctx context.Context, sessionId string, body PostSessionInvestigationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionOffers request
+	GetSessionOffers(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionOfferAccept request
+	PostSessionOfferAccept(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionOfferDecline request
+	PostSessionOfferDecline(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetSessionPhase request
-	GetSessionPhase(ctx context.Context, sessionId string, params *GetSessionPhaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSessionPhase(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostSessionPlayersWithBody request with any body
 	PostSessionPlayersWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostSessionPlayers(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
 
-func (c *Client) PostSessionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionsRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// PostSessionReadyWithBody request with any body
+	PostSessionReadyWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (c *Client) PostSessions(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionsRequest(c.Server, body)
+	PostSessionReady(ctx context.Context, sessionId string, body PostSessionReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionRewind request
+	PostSessionRewind(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionRolesWithBody request with any body
+	PostSessionRolesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionRoles(ctx context.Context, sessionId string, body PostSessionRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionStart request
+	PostSessionStart(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionTimer request
+	GetSessionTimer(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionTimerExtendWithBody request with any body
+	PostSessionTimerExtendWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionTimerExtend(ctx context.Context, sessionId string, body PostSessionTimerExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionTimerPause request
+	PostSessionTimerPause(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionTimerResume request
+	PostSessionTimerResume(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionTransitions request
+	GetSessionTransitions(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionVotesWithBody request with any body
+	PostSessionVotesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionVotes(ctx context.Context, sessionId string, body PostSessionVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionVoteDecisionWithBody request with any body
+	PostSessionVoteDecisionWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionVoteDecision(ctx context.Context, sessionId string, body PostSessionVoteDecisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionVoteResult request
+	GetSessionVoteResult(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetJoinCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJoinCodeRequest(c.Server, code)
 	if err != nil {
 		return nil, err
 	}
@@ -226,8 +1032,8 @@ func (c *Client) PostSessions(ctx context.Context, body PostSessionsJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSessionAdvance(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionAdvanceRequest(c.Server, sessionId)
+func (c *Client) GetScenarioGenerations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScenarioGenerationsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -238,8 +1044,8 @@ func (c *Client) PostSessionAdvance(ctx context.Context, sessionId string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSessionPhase(ctx context.Context, sessionId string, params *GetSessionPhaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSessionPhaseRequest(c.Server, sessionId, params)
+func (c *Client) GetScenarios(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScenariosRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -250,8 +1056,8 @@ func (c *Client) GetSessionPhase(ctx context.Context, sessionId string, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSessionPlayersWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionPlayersRequestWithBody(c.Server, sessionId, contentType, body)
+func (c *Client) PostScenariosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScenariosRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -262,8 +1068,8 @@ func (c *Client) PostSessionPlayersWithBody(ctx context.Context, sessionId strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSessionPlayers(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionPlayersRequest(c.Server, sessionId, body)
+func (c *Client) PostScenarios(ctx context.Context, body PostScenariosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScenariosRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -274,510 +1080,6033 @@ func (c *Client) PostSessionPlayers(ctx context.Context, sessionId string, body
 	return c.Client.Do(req)
 }
 
-// NewPostSessionsRequest calls the generic PostSessions builder with application/json body
-func NewPostSessionsRequest(server string, body PostSessionsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetScenario(ctx context.Context, scenarioId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScenarioRequest(c.Server, scenarioId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSessionsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSessionsRequestWithBody generates requests for PostSessions with any type of body
-func NewPostSessionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostSessionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sessions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSessions(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSessionAdvanceRequest generates requests for PostSessionAdvance
-func NewPostSessionAdvanceRequest(server string, sessionId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+func (c *Client) PostSessionAdvanceWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionAdvanceRequestWithBody(c.Server, sessionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostSessionAdvance(ctx context.Context, sessionId string, body PostSessionAdvanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionAdvanceRequest(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sessions/%s/advance", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSessionChat(ctx context.Context, sessionId string, params *GetSessionChatParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionChatRequest(c.Server, sessionId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PutSessionWhisperPhasesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSessionWhisperPhasesRequestWithBody(c.Server, sessionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetSessionPhaseRequest generates requests for GetSessionPhase
-func NewGetSessionPhaseRequest(server string, sessionId string, params *GetSessionPhaseParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+func (c *Client) PutSessionWhisperPhases(ctx context.Context, sessionId string, body PutSessionWhisperPhasesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSessionWhisperPhasesRequest(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSessionClues(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionCluesRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sessions/%s/phase", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSessionClueOffersWithBody(ctx context.Context, sessionId string, clueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionClueOffersRequestWithBody(c.Server, sessionId, clueId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostSessionClueOffers(ctx context.Context, sessionId string, clueId string, body PostSessionClueOffersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionClueOffersRequest(c.Server, sessionId, clueId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Player-Id", runtime.ParamLocationHeader, params.XPlayerId)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Player-Id", headerParam0)
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostSessionPlayersRequest calls the generic PostSessionPlayers builder with application/json body
-func NewPostSessionPlayersRequest(server string, sessionId string, body PostSessionPlayersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostSessionClueReveal(ctx context.Context, sessionId string, clueId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionClueRevealRequest(c.Server, sessionId, clueId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostSessionPlayersRequestWithBody(server, sessionId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSessionPlayersRequestWithBody generates requests for PostSessionPlayers with any type of body
-func NewPostSessionPlayersRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+func (c *Client) GetSessionEnding(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionEndingRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostSessionEndingGoalsWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionEndingGoalsRequestWithBody(c.Server, sessionId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sessions/%s/players", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSessionEndingGoals(ctx context.Context, sessionId string, body PostSessionEndingGoalsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionEndingGoalsRequest(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetSessionEvents(ctx context.Context, sessionId string, params *GetSessionEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionEventsRequest(c.Server, sessionId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) GetSessionHandouts(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionHandoutsRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostSessionHostWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionHostRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostSessionHost(ctx context.Context, sessionId string, body PostSessionHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionHostRequest(c.Server, sessionId, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostSessionInvestigationsWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionInvestigationsRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostSessionsWithBodyWithResponse request with any body
-	PostSessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error)
+func (c *Client) PostSessionInvestigations(ctx context.Context, sessionId string, body PostSessionInvestigationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionInvestigationsRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	PostSessionsWithResponse(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error)
+func (c *Client) GetSessionOffers(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionOffersRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// PostSessionAdvanceWithResponse request
-	PostSessionAdvanceWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error)
+func (c *Client) PostSessionOfferAccept(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionOfferAcceptRequest(c.Server, sessionId, offerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// GetSessionPhaseWithResponse request
-	GetSessionPhaseWithResponse(ctx context.Context, sessionId string, params *GetSessionPhaseParams, reqEditors ...RequestEditorFn) (*GetSessionPhaseResponse, error)
+func (c *Client) PostSessionOfferDecline(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionOfferDeclineRequest(c.Server, sessionId, offerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// PostSessionPlayersWithBodyWithResponse request with any body
-	PostSessionPlayersWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error)
+func (c *Client) GetSessionPhase(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionPhaseRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	PostSessionPlayersWithResponse(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error)
+func (c *Client) PostSessionPlayersWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionPlayersRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostSessionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateSessionResponse
+func (c *Client) PostSessionPlayers(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionPlayersRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSessionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostSessionReadyWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionReadyRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSessionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostSessionReady(ctx context.Context, sessionId string, body PostSessionReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionReadyRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostSessionAdvanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AdvancePhaseResponse
+func (c *Client) PostSessionRewind(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionRewindRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSessionAdvanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostSessionRolesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionRolesRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSessionAdvanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostSessionRoles(ctx context.Context, sessionId string, body PostSessionRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionRolesRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetSessionPhaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PhaseResponse
+func (c *Client) PostSessionStart(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionStartRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSessionPhaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetSessionTimer(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionTimerRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSessionPhaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostSessionTimerExtendWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionTimerExtendRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostSessionPlayersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JoinPlayerResponse
+func (c *Client) PostSessionTimerExtend(ctx context.Context, sessionId string, body PostSessionTimerExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionTimerExtendRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSessionPlayersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostSessionTimerPause(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionTimerPauseRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSessionPlayersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PostSessionTimerResume(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionTimerResumeRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// PostSessionsWithBodyWithResponse request with arbitrary body returning *PostSessionsResponse
-func (c *ClientWithResponses) PostSessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error) {
-	rsp, err := c.PostSessionsWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) GetSessionTransitions(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionTransitionsRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSessionsResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionVotesWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionVotesRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionVotes(ctx context.Context, sessionId string, body PostSessionVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionVotesRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionVoteDecisionWithBody(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionVoteDecisionRequestWithBody(c.Server, sessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionVoteDecision(ctx context.Context, sessionId string, body PostSessionVoteDecisionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionVoteDecisionRequest(c.Server, sessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) PostSessionsWithResponse(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error) {
-	rsp, err := c.PostSessions(ctx, body, reqEditors...)
+func (c *Client) GetSessionVoteResult(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionVoteResultRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetJoinCodeRequest generates requests for GetJoinCode
+func NewGetJoinCodeRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/join/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScenarioGenerationsRequest generates requests for GetScenarioGenerations
+func NewGetScenarioGenerationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenario-generations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScenariosRequest generates requests for GetScenarios
+func NewGetScenariosRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenarios")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScenariosRequest calls the generic PostScenarios builder with application/json body
+func NewPostScenariosRequest(server string, body PostScenariosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScenariosRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScenariosRequestWithBody generates requests for PostScenarios with any type of body
+func NewPostScenariosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenarios")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScenarioRequest generates requests for GetScenario
+func NewGetScenarioRequest(server string, scenarioId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scenarioId", runtime.ParamLocationPath, scenarioId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenarios/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionsRequest calls the generic PostSessions builder with application/json body
+func NewPostSessionsRequest(server string, body PostSessionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSessionsRequestWithBody generates requests for PostSessions with any type of body
+func NewPostSessionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionRequest generates requests for GetSession
+func NewGetSessionRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionAdvanceRequest calls the generic PostSessionAdvance builder with application/json body
+func NewPostSessionAdvanceRequest(server string, sessionId string, body PostSessionAdvanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionAdvanceRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionAdvanceRequestWithBody generates requests for PostSessionAdvance with any type of body
+func NewPostSessionAdvanceRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/advance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionChatRequest generates requests for GetSessionChat
+func NewGetSessionChatRequest(server string, sessionId string, params *GetSessionChatParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/chat", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSessionWhisperPhasesRequest calls the generic PutSessionWhisperPhases builder with application/json body
+func NewPutSessionWhisperPhasesRequest(server string, sessionId string, body PutSessionWhisperPhasesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSessionWhisperPhasesRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPutSessionWhisperPhasesRequestWithBody generates requests for PutSessionWhisperPhases with any type of body
+func NewPutSessionWhisperPhasesRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/chat/whisper-phases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionCluesRequest generates requests for GetSessionClues
+func NewGetSessionCluesRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/clues", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionClueOffersRequest calls the generic PostSessionClueOffers builder with application/json body
+func NewPostSessionClueOffersRequest(server string, sessionId string, clueId string, body PostSessionClueOffersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionClueOffersRequestWithBody(server, sessionId, clueId, "application/json", bodyReader)
+}
+
+// NewPostSessionClueOffersRequestWithBody generates requests for PostSessionClueOffers with any type of body
+func NewPostSessionClueOffersRequestWithBody(server string, sessionId string, clueId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clueId", runtime.ParamLocationPath, clueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/clues/%s/offers", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionClueRevealRequest generates requests for PostSessionClueReveal
+func NewPostSessionClueRevealRequest(server string, sessionId string, clueId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clueId", runtime.ParamLocationPath, clueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/clues/%s/reveal", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionEndingRequest generates requests for GetSessionEnding
+func NewGetSessionEndingRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/ending", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionEndingGoalsRequest calls the generic PostSessionEndingGoals builder with application/json body
+func NewPostSessionEndingGoalsRequest(server string, sessionId string, body PostSessionEndingGoalsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionEndingGoalsRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionEndingGoalsRequestWithBody generates requests for PostSessionEndingGoals with any type of body
+func NewPostSessionEndingGoalsRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/ending/goals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionEventsRequest generates requests for GetSessionEvents
+func NewGetSessionEventsRequest(server string, sessionId string, params *GetSessionEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetSessionHandoutsRequest generates requests for GetSessionHandouts
+func NewGetSessionHandoutsRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/handouts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionHostRequest calls the generic PostSessionHost builder with application/json body
+func NewPostSessionHostRequest(server string, sessionId string, body PostSessionHostJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionHostRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionHostRequestWithBody generates requests for PostSessionHost with any type of body
+func NewPostSessionHostRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/host", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionInvestigationsRequest calls the generic PostSessionInvestigations builder with application/json body
+func NewPostSessionInvestigationsRequest(server string, sessionId string, body PostSessionInvestigationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionInvestigationsRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionInvestigationsRequestWithBody generates requests for PostSessionInvestigations with any type of body
+func NewPostSessionInvestigationsRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/investigations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionOffersRequest generates requests for GetSessionOffers
+func NewGetSessionOffersRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/offers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionOfferAcceptRequest generates requests for PostSessionOfferAccept
+func NewPostSessionOfferAcceptRequest(server string, sessionId string, offerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "offerId", runtime.ParamLocationPath, offerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/offers/%s/accept", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionOfferDeclineRequest generates requests for PostSessionOfferDecline
+func NewPostSessionOfferDeclineRequest(server string, sessionId string, offerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "offerId", runtime.ParamLocationPath, offerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/offers/%s/decline", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionPhaseRequest generates requests for GetSessionPhase
+func NewGetSessionPhaseRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/phase", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionPlayersRequest calls the generic PostSessionPlayers builder with application/json body
+func NewPostSessionPlayersRequest(server string, sessionId string, body PostSessionPlayersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionPlayersRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionPlayersRequestWithBody generates requests for PostSessionPlayers with any type of body
+func NewPostSessionPlayersRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/players", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionReadyRequest calls the generic PostSessionReady builder with application/json body
+func NewPostSessionReadyRequest(server string, sessionId string, body PostSessionReadyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionReadyRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionReadyRequestWithBody generates requests for PostSessionReady with any type of body
+func NewPostSessionReadyRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/ready", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionRewindRequest generates requests for PostSessionRewind
+func NewPostSessionRewindRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/rewind", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionRolesRequest calls the generic PostSessionRoles builder with application/json body
+func NewPostSessionRolesRequest(server string, sessionId string, body PostSessionRolesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionRolesRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionRolesRequestWithBody generates requests for PostSessionRoles with any type of body
+func NewPostSessionRolesRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionStartRequest generates requests for PostSessionStart
+func NewPostSessionStartRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionTimerRequest generates requests for GetSessionTimer
+func NewGetSessionTimerRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/timer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionTimerExtendRequest calls the generic PostSessionTimerExtend builder with application/json body
+func NewPostSessionTimerExtendRequest(server string, sessionId string, body PostSessionTimerExtendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionTimerExtendRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionTimerExtendRequestWithBody generates requests for PostSessionTimerExtend with any type of body
+func NewPostSessionTimerExtendRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/timer/extend", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionTimerPauseRequest generates requests for PostSessionTimerPause
+func NewPostSessionTimerPauseRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/timer/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionTimerResumeRequest generates requests for PostSessionTimerResume
+func NewPostSessionTimerResumeRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/timer/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionTransitionsRequest generates requests for GetSessionTransitions
+func NewGetSessionTransitionsRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/transitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionVotesRequest calls the generic PostSessionVotes builder with application/json body
+func NewPostSessionVotesRequest(server string, sessionId string, body PostSessionVotesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionVotesRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionVotesRequestWithBody generates requests for PostSessionVotes with any type of body
+func NewPostSessionVotesRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/votes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionVoteDecisionRequest calls the generic PostSessionVoteDecision builder with application/json body
+func NewPostSessionVoteDecisionRequest(server string, sessionId string, body PostSessionVoteDecisionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionVoteDecisionRequestWithBody(server, sessionId, "application/json", bodyReader)
+}
+
+// NewPostSessionVoteDecisionRequestWithBody generates requests for PostSessionVoteDecision with any type of body
+func NewPostSessionVoteDecisionRequestWithBody(server string, sessionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/votes/decision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionVoteResultRequest generates requests for GetSessionVoteResult
+func NewGetSessionVoteResultRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sessions/%s/votes/result", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJoinCodeWithResponse request
+	GetJoinCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetJoinCodeResponse, error)
+
+	// GetScenarioGenerationsWithResponse request
+	GetScenarioGenerationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScenarioGenerationsResponse, error)
+
+	// GetScenariosWithResponse request
+	GetScenariosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScenariosResponse, error)
+
+	// PostScenariosWithBodyWithResponse request with any body
+	PostScenariosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScenariosResponse, error)
+
+	PostScenariosWithResponse(ctx context.Context, body PostScenariosJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScenariosResponse, error)
+
+	// GetScenarioWithResponse request
+	GetScenarioWithResponse(ctx context.Context, scenarioId string, reqEditors ...RequestEditorFn) (*GetScenarioResponse, error)
+
+	// PostSessionsWithBodyWithResponse request with any body
+	PostSessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error)
+
+	PostSessionsWithResponse(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error)
+
+	// GetSessionWithResponse request
+	GetSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionResponse, error)
+
+	// PostSessionAdvanceWithBodyWithResponse request with any body
+	PostSessionAdvanceWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error)
+
+	PostSessionAdvanceWithResponse(ctx context.Context, sessionId string, body PostSessionAdvanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error)
+
+	// GetSessionChatWithResponse request
+	GetSessionChatWithResponse(ctx context.Context, sessionId string, params *GetSessionChatParams, reqEditors ...RequestEditorFn) (*GetSessionChatResponse, error)
+
+	// PutSessionWhisperPhasesWithBodyWithResponse request with any body
+	PutSessionWhisperPhasesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSessionWhisperPhasesResponse, error)
+
+	PutSessionWhisperPhasesWithResponse(ctx context.Context, sessionId string, body PutSessionWhisperPhasesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSessionWhisperPhasesResponse, error)
+
+	// GetSessionCluesWithResponse request
+	GetSessionCluesWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionCluesResponse, error)
+
+	// PostSessionClueOffersWithBodyWithResponse request with any body
+	PostSessionClueOffersWithBodyWithResponse(ctx context.Context, sessionId string, clueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionClueOffersResponse, error)
+
+	PostSessionClueOffersWithResponse(ctx context.Context, sessionId string, clueId string, body PostSessionClueOffersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionClueOffersResponse, error)
+
+	// PostSessionClueRevealWithResponse request
+	PostSessionClueRevealWithResponse(ctx context.Context, sessionId string, clueId string, reqEditors ...RequestEditorFn) (*PostSessionClueRevealResponse, error)
+
+	// GetSessionEndingWithResponse request
+	GetSessionEndingWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionEndingResponse, error)
+
+	// PostSessionEndingGoalsWithBodyWithResponse request with any body
+	PostSessionEndingGoalsWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionEndingGoalsResponse, error)
+
+	PostSessionEndingGoalsWithResponse(ctx context.Context, sessionId string, body PostSessionEndingGoalsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionEndingGoalsResponse, error)
+
+	// GetSessionEventsWithResponse request
+	GetSessionEventsWithResponse(ctx context.Context, sessionId string, params *GetSessionEventsParams, reqEditors ...RequestEditorFn) (*GetSessionEventsResponse, error)
+
+	// GetSessionHandoutsWithResponse request
+	GetSessionHandoutsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionHandoutsResponse, error)
+
+	// PostSessionHostWithBodyWithResponse request with any body
+	PostSessionHostWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionHostResponse, error)
+
+	PostSessionHostWithResponse(ctx context.Context, sessionId string, body PostSessionHostJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionHostResponse, error)
+
+	// PostSessionInvestigationsWithBodyWithResponse request with any body
+	PostSessionInvestigationsWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionInvestigationsResponse, error)
+
+	PostSessionInvestigationsWithResponse(ctx context.Context, sessionId string, body PostSessionInvestigationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionInvestigationsResponse, error)
+
+	// GetSessionOffersWithResponse request
+	GetSessionOffersWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionOffersResponse, error)
+
+	// PostSessionOfferAcceptWithResponse request
+	PostSessionOfferAcceptWithResponse(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*PostSessionOfferAcceptResponse, error)
+
+	// PostSessionOfferDeclineWithResponse request
+	PostSessionOfferDeclineWithResponse(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*PostSessionOfferDeclineResponse, error)
+
+	// GetSessionPhaseWithResponse request
+	GetSessionPhaseWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionPhaseResponse, error)
+
+	// PostSessionPlayersWithBodyWithResponse request with any body
+	PostSessionPlayersWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error)
+
+	PostSessionPlayersWithResponse(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error)
+
+	// PostSessionReadyWithBodyWithResponse request with any body
+	PostSessionReadyWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionReadyResponse, error)
+
+	PostSessionReadyWithResponse(ctx context.Context, sessionId string, body PostSessionReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionReadyResponse, error)
+
+	// PostSessionRewindWithResponse request
+	PostSessionRewindWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionRewindResponse, error)
+
+	// PostSessionRolesWithBodyWithResponse request with any body
+	PostSessionRolesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionRolesResponse, error)
+
+	PostSessionRolesWithResponse(ctx context.Context, sessionId string, body PostSessionRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionRolesResponse, error)
+
+	// PostSessionStartWithResponse request
+	PostSessionStartWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionStartResponse, error)
+
+	// GetSessionTimerWithResponse request
+	GetSessionTimerWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionTimerResponse, error)
+
+	// PostSessionTimerExtendWithBodyWithResponse request with any body
+	PostSessionTimerExtendWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionTimerExtendResponse, error)
+
+	PostSessionTimerExtendWithResponse(ctx context.Context, sessionId string, body PostSessionTimerExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionTimerExtendResponse, error)
+
+	// PostSessionTimerPauseWithResponse request
+	PostSessionTimerPauseWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionTimerPauseResponse, error)
+
+	// PostSessionTimerResumeWithResponse request
+	PostSessionTimerResumeWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionTimerResumeResponse, error)
+
+	// GetSessionTransitionsWithResponse request
+	GetSessionTransitionsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionTransitionsResponse, error)
+
+	// PostSessionVotesWithBodyWithResponse request with any body
+	PostSessionVotesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionVotesResponse, error)
+
+	PostSessionVotesWithResponse(ctx context.Context, sessionId string, body PostSessionVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionVotesResponse, error)
+
+	// PostSessionVoteDecisionWithBodyWithResponse request with any body
+	PostSessionVoteDecisionWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionVoteDecisionResponse, error)
+
+	PostSessionVoteDecisionWithResponse(ctx context.Context, sessionId string, body PostSessionVoteDecisionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionVoteDecisionResponse, error)
+
+	// GetSessionVoteResultWithResponse request
+	GetSessionVoteResultWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionVoteResultResponse, error)
+}
+
+type GetJoinCodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JoinCodeResponse
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJoinCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJoinCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScenarioGenerationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *GenerationHistoryResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScenarioGenerationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScenarioGenerationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScenariosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScenarioListResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScenariosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScenariosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScenariosResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScenarioSummary
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScenariosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScenariosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScenarioResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScenarioResponse
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScenarioResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScenarioResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CreateSessionResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON502 *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LobbyResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionAdvanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdvancePhaseResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionAdvanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionAdvanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionChatResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionChatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionChatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSessionWhisperPhasesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WhisperPhases
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSessionWhisperPhasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSessionWhisperPhasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionCluesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueListResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionCluesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionCluesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionClueOffersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueOffer
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionClueOffersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionClueOffersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionClueRevealResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueCard
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionClueRevealResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionClueRevealResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionEndingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *EndingResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionEndingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionEndingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionEndingGoalsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionEndingGoalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionEndingGoalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionHandoutsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionHandoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionHandoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionHostResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransferHostResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionHostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionHostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionInvestigationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *InvestigateResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionInvestigationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionInvestigationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionOffersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueOfferListResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionOffersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionOffersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionOfferAcceptResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueOffer
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionOfferAcceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionOfferAcceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionOfferDeclineResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ClueOffer
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionOfferDeclineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionOfferDeclineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionPhaseResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PhaseResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionPhaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionPhaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionPlayersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JoinPlayerResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionPlayersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionPlayersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionReadyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LobbyPlayer
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionRewindResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AdvancePhaseResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionRewindResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionRewindResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionRolesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DealRolesResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionStartResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LobbyResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionTimerResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TimerResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionTimerExtendResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TimerResponse
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionTimerExtendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionTimerExtendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionTimerPauseResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TimerResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionTimerPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionTimerPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionTimerResumeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TimerResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionTimerResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionTimerResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionTransitionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TransitionListResponse
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionTransitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionTransitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionVotesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionVotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionVotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionVoteDecisionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionVoteDecisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionVoteDecisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionVoteResultResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *VoteResult
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionVoteResultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionVoteResultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJoinCodeWithResponse request returning *GetJoinCodeResponse
+func (c *ClientWithResponses) GetJoinCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetJoinCodeResponse, error) {
+	rsp, err := c.GetJoinCode(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJoinCodeResponse(rsp)
+}
+
+// GetScenarioGenerationsWithResponse request returning *GetScenarioGenerationsResponse
+func (c *ClientWithResponses) GetScenarioGenerationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScenarioGenerationsResponse, error) {
+	rsp, err := c.GetScenarioGenerations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScenarioGenerationsResponse(rsp)
+}
+
+// GetScenariosWithResponse request returning *GetScenariosResponse
+func (c *ClientWithResponses) GetScenariosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScenariosResponse, error) {
+	rsp, err := c.GetScenarios(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScenariosResponse(rsp)
+}
+
+// PostScenariosWithBodyWithResponse request with arbitrary body returning *PostScenariosResponse
+func (c *ClientWithResponses) PostScenariosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScenariosResponse, error) {
+	rsp, err := c.PostScenariosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScenariosResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScenariosWithResponse(ctx context.Context, body PostScenariosJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScenariosResponse, error) {
+	rsp, err := c.PostScenarios(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScenariosResponse(rsp)
+}
+
+// GetScenarioWithResponse request returning *GetScenarioResponse
+func (c *ClientWithResponses) GetScenarioWithResponse(ctx context.Context, scenarioId string, reqEditors ...RequestEditorFn) (*GetScenarioResponse, error) {
+	rsp, err := c.GetScenario(ctx, scenarioId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScenarioResponse(rsp)
+}
+
+// PostSessionsWithBodyWithResponse request with arbitrary body returning *PostSessionsResponse
+func (c *ClientWithResponses) PostSessionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error) {
+	rsp, err := c.PostSessionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionsWithResponse(ctx context.Context, body PostSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionsResponse, error) {
+	rsp, err := c.PostSessions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionsResponse(rsp)
+}
+
+// GetSessionWithResponse request returning *GetSessionResponse
+func (c *ClientWithResponses) GetSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionResponse, error) {
+	rsp, err := c.GetSession(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionResponse(rsp)
+}
+
+// PostSessionAdvanceWithBodyWithResponse request with arbitrary body returning *PostSessionAdvanceResponse
+func (c *ClientWithResponses) PostSessionAdvanceWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error) {
+	rsp, err := c.PostSessionAdvanceWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionAdvanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionAdvanceWithResponse(ctx context.Context, sessionId string, body PostSessionAdvanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error) {
+	rsp, err := c.PostSessionAdvance(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionAdvanceResponse(rsp)
+}
+
+// GetSessionChatWithResponse request returning *GetSessionChatResponse
+func (c *ClientWithResponses) GetSessionChatWithResponse(ctx context.Context, sessionId string, params *GetSessionChatParams, reqEditors ...RequestEditorFn) (*GetSessionChatResponse, error) {
+	rsp, err := c.GetSessionChat(ctx, sessionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionChatResponse(rsp)
+}
+
+// PutSessionWhisperPhasesWithBodyWithResponse request with arbitrary body returning *PutSessionWhisperPhasesResponse
+func (c *ClientWithResponses) PutSessionWhisperPhasesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSessionWhisperPhasesResponse, error) {
+	rsp, err := c.PutSessionWhisperPhasesWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSessionWhisperPhasesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSessionWhisperPhasesWithResponse(ctx context.Context, sessionId string, body PutSessionWhisperPhasesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSessionWhisperPhasesResponse, error) {
+	rsp, err := c.PutSessionWhisperPhases(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSessionWhisperPhasesResponse(rsp)
+}
+
+// GetSessionCluesWithResponse request returning *GetSessionCluesResponse
+func (c *ClientWithResponses) GetSessionCluesWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionCluesResponse, error) {
+	rsp, err := c.GetSessionClues(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionCluesResponse(rsp)
+}
+
+// PostSessionClueOffersWithBodyWithResponse request with arbitrary body returning *PostSessionClueOffersResponse
+func (c *ClientWithResponses) PostSessionClueOffersWithBodyWithResponse(ctx context.Context, sessionId string, clueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionClueOffersResponse, error) {
+	rsp, err := c.PostSessionClueOffersWithBody(ctx, sessionId, clueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionClueOffersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionClueOffersWithResponse(ctx context.Context, sessionId string, clueId string, body PostSessionClueOffersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionClueOffersResponse, error) {
+	rsp, err := c.PostSessionClueOffers(ctx, sessionId, clueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionClueOffersResponse(rsp)
+}
+
+// PostSessionClueRevealWithResponse request returning *PostSessionClueRevealResponse
+func (c *ClientWithResponses) PostSessionClueRevealWithResponse(ctx context.Context, sessionId string, clueId string, reqEditors ...RequestEditorFn) (*PostSessionClueRevealResponse, error) {
+	rsp, err := c.PostSessionClueReveal(ctx, sessionId, clueId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionClueRevealResponse(rsp)
+}
+
+// GetSessionEndingWithResponse request returning *GetSessionEndingResponse
+func (c *ClientWithResponses) GetSessionEndingWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionEndingResponse, error) {
+	rsp, err := c.GetSessionEnding(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionEndingResponse(rsp)
+}
+
+// PostSessionEndingGoalsWithBodyWithResponse request with arbitrary body returning *PostSessionEndingGoalsResponse
+func (c *ClientWithResponses) PostSessionEndingGoalsWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionEndingGoalsResponse, error) {
+	rsp, err := c.PostSessionEndingGoalsWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionEndingGoalsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionEndingGoalsWithResponse(ctx context.Context, sessionId string, body PostSessionEndingGoalsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionEndingGoalsResponse, error) {
+	rsp, err := c.PostSessionEndingGoals(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionEndingGoalsResponse(rsp)
+}
+
+// GetSessionEventsWithResponse request returning *GetSessionEventsResponse
+func (c *ClientWithResponses) GetSessionEventsWithResponse(ctx context.Context, sessionId string, params *GetSessionEventsParams, reqEditors ...RequestEditorFn) (*GetSessionEventsResponse, error) {
+	rsp, err := c.GetSessionEvents(ctx, sessionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionEventsResponse(rsp)
+}
+
+// GetSessionHandoutsWithResponse request returning *GetSessionHandoutsResponse
+func (c *ClientWithResponses) GetSessionHandoutsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionHandoutsResponse, error) {
+	rsp, err := c.GetSessionHandouts(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionHandoutsResponse(rsp)
+}
+
+// PostSessionHostWithBodyWithResponse request with arbitrary body returning *PostSessionHostResponse
+func (c *ClientWithResponses) PostSessionHostWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionHostResponse, error) {
+	rsp, err := c.PostSessionHostWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionHostResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionHostWithResponse(ctx context.Context, sessionId string, body PostSessionHostJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionHostResponse, error) {
+	rsp, err := c.PostSessionHost(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionHostResponse(rsp)
+}
+
+// PostSessionInvestigationsWithBodyWithResponse request with arbitrary body returning *PostSessionInvestigationsResponse
+func (c *ClientWithResponses) PostSessionInvestigationsWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionInvestigationsResponse, error) {
+	rsp, err := c.PostSessionInvestigationsWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionInvestigationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionInvestigationsWithResponse(ctx context.Context, sessionId string, body PostSessionInvestigationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionInvestigationsResponse, error) {
+	rsp, err := c.PostSessionInvestigations(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionInvestigationsResponse(rsp)
+}
+
+// GetSessionOffersWithResponse request returning *GetSessionOffersResponse
+func (c *ClientWithResponses) GetSessionOffersWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionOffersResponse, error) {
+	rsp, err := c.GetSessionOffers(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionOffersResponse(rsp)
+}
+
+// PostSessionOfferAcceptWithResponse request returning *PostSessionOfferAcceptResponse
+func (c *ClientWithResponses) PostSessionOfferAcceptWithResponse(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*PostSessionOfferAcceptResponse, error) {
+	rsp, err := c.PostSessionOfferAccept(ctx, sessionId, offerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionOfferAcceptResponse(rsp)
+}
+
+// PostSessionOfferDeclineWithResponse request returning *PostSessionOfferDeclineResponse
+func (c *ClientWithResponses) PostSessionOfferDeclineWithResponse(ctx context.Context, sessionId string, offerId string, reqEditors ...RequestEditorFn) (*PostSessionOfferDeclineResponse, error) {
+	rsp, err := c.PostSessionOfferDecline(ctx, sessionId, offerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionOfferDeclineResponse(rsp)
+}
+
+// GetSessionPhaseWithResponse request returning *GetSessionPhaseResponse
+func (c *ClientWithResponses) GetSessionPhaseWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionPhaseResponse, error) {
+	rsp, err := c.GetSessionPhase(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionPhaseResponse(rsp)
+}
+
+// PostSessionPlayersWithBodyWithResponse request with arbitrary body returning *PostSessionPlayersResponse
+func (c *ClientWithResponses) PostSessionPlayersWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error) {
+	rsp, err := c.PostSessionPlayersWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionPlayersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionPlayersWithResponse(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error) {
+	rsp, err := c.PostSessionPlayers(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionPlayersResponse(rsp)
+}
+
+// PostSessionReadyWithBodyWithResponse request with arbitrary body returning *PostSessionReadyResponse
+func (c *ClientWithResponses) PostSessionReadyWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionReadyResponse, error) {
+	rsp, err := c.PostSessionReadyWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionReadyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionReadyWithResponse(ctx context.Context, sessionId string, body PostSessionReadyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionReadyResponse, error) {
+	rsp, err := c.PostSessionReady(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionReadyResponse(rsp)
+}
+
+// PostSessionRewindWithResponse request returning *PostSessionRewindResponse
+func (c *ClientWithResponses) PostSessionRewindWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionRewindResponse, error) {
+	rsp, err := c.PostSessionRewind(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionRewindResponse(rsp)
+}
+
+// PostSessionRolesWithBodyWithResponse request with arbitrary body returning *PostSessionRolesResponse
+func (c *ClientWithResponses) PostSessionRolesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionRolesResponse, error) {
+	rsp, err := c.PostSessionRolesWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionRolesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionRolesWithResponse(ctx context.Context, sessionId string, body PostSessionRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionRolesResponse, error) {
+	rsp, err := c.PostSessionRoles(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionRolesResponse(rsp)
+}
+
+// PostSessionStartWithResponse request returning *PostSessionStartResponse
+func (c *ClientWithResponses) PostSessionStartWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionStartResponse, error) {
+	rsp, err := c.PostSessionStart(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionStartResponse(rsp)
+}
+
+// GetSessionTimerWithResponse request returning *GetSessionTimerResponse
+func (c *ClientWithResponses) GetSessionTimerWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionTimerResponse, error) {
+	rsp, err := c.GetSessionTimer(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionTimerResponse(rsp)
+}
+
+// PostSessionTimerExtendWithBodyWithResponse request with arbitrary body returning *PostSessionTimerExtendResponse
+func (c *ClientWithResponses) PostSessionTimerExtendWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionTimerExtendResponse, error) {
+	rsp, err := c.PostSessionTimerExtendWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionTimerExtendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionTimerExtendWithResponse(ctx context.Context, sessionId string, body PostSessionTimerExtendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionTimerExtendResponse, error) {
+	rsp, err := c.PostSessionTimerExtend(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionTimerExtendResponse(rsp)
+}
+
+// PostSessionTimerPauseWithResponse request returning *PostSessionTimerPauseResponse
+func (c *ClientWithResponses) PostSessionTimerPauseWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionTimerPauseResponse, error) {
+	rsp, err := c.PostSessionTimerPause(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionTimerPauseResponse(rsp)
+}
+
+// PostSessionTimerResumeWithResponse request returning *PostSessionTimerResumeResponse
+func (c *ClientWithResponses) PostSessionTimerResumeWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionTimerResumeResponse, error) {
+	rsp, err := c.PostSessionTimerResume(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionTimerResumeResponse(rsp)
+}
+
+// GetSessionTransitionsWithResponse request returning *GetSessionTransitionsResponse
+func (c *ClientWithResponses) GetSessionTransitionsWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionTransitionsResponse, error) {
+	rsp, err := c.GetSessionTransitions(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionTransitionsResponse(rsp)
+}
+
+// PostSessionVotesWithBodyWithResponse request with arbitrary body returning *PostSessionVotesResponse
+func (c *ClientWithResponses) PostSessionVotesWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionVotesResponse, error) {
+	rsp, err := c.PostSessionVotesWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionVotesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionVotesWithResponse(ctx context.Context, sessionId string, body PostSessionVotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionVotesResponse, error) {
+	rsp, err := c.PostSessionVotes(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionVotesResponse(rsp)
+}
+
+// PostSessionVoteDecisionWithBodyWithResponse request with arbitrary body returning *PostSessionVoteDecisionResponse
+func (c *ClientWithResponses) PostSessionVoteDecisionWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionVoteDecisionResponse, error) {
+	rsp, err := c.PostSessionVoteDecisionWithBody(ctx, sessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionVoteDecisionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionVoteDecisionWithResponse(ctx context.Context, sessionId string, body PostSessionVoteDecisionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionVoteDecisionResponse, error) {
+	rsp, err := c.PostSessionVoteDecision(ctx, sessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionVoteDecisionResponse(rsp)
+}
+
+// GetSessionVoteResultWithResponse request returning *GetSessionVoteResultResponse
+func (c *ClientWithResponses) GetSessionVoteResultWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*GetSessionVoteResultResponse, error) {
+	rsp, err := c.GetSessionVoteResult(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionVoteResultResponse(rsp)
+}
+
+// ParseGetJoinCodeResponse parses an HTTP response from a GetJoinCodeWithResponse call
+func ParseGetJoinCodeResponse(rsp *http.Response) (*GetJoinCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJoinCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JoinCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScenarioGenerationsResponse parses an HTTP response from a GetScenarioGenerationsWithResponse call
+func ParseGetScenarioGenerationsResponse(rsp *http.Response) (*GetScenarioGenerationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScenarioGenerationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GenerationHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScenariosResponse parses an HTTP response from a GetScenariosWithResponse call
+func ParseGetScenariosResponse(rsp *http.Response) (*GetScenariosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScenariosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScenarioListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostScenariosResponse parses an HTTP response from a PostScenariosWithResponse call
+func ParsePostScenariosResponse(rsp *http.Response) (*PostScenariosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostScenariosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScenarioSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScenarioResponse parses an HTTP response from a GetScenarioWithResponse call
+func ParseGetScenarioResponse(rsp *http.Response) (*GetScenarioResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScenarioResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScenarioResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionsResponse parses an HTTP response from a PostSessionsWithResponse call
+func ParsePostSessionsResponse(rsp *http.Response) (*PostSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionResponse parses an HTTP response from a GetSessionWithResponse call
+func ParseGetSessionResponse(rsp *http.Response) (*GetSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LobbyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionAdvanceResponse parses an HTTP response from a PostSessionAdvanceWithResponse call
+func ParsePostSessionAdvanceResponse(rsp *http.Response) (*PostSessionAdvanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionAdvanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvancePhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionChatResponse parses an HTTP response from a GetSessionChatWithResponse call
+func ParseGetSessionChatResponse(rsp *http.Response) (*GetSessionChatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionChatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSessionWhisperPhasesResponse parses an HTTP response from a PutSessionWhisperPhasesWithResponse call
+func ParsePutSessionWhisperPhasesResponse(rsp *http.Response) (*PutSessionWhisperPhasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSessionWhisperPhasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WhisperPhases
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionCluesResponse parses an HTTP response from a GetSessionCluesWithResponse call
+func ParseGetSessionCluesResponse(rsp *http.Response) (*GetSessionCluesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionCluesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionClueOffersResponse parses an HTTP response from a PostSessionClueOffersWithResponse call
+func ParsePostSessionClueOffersResponse(rsp *http.Response) (*PostSessionClueOffersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionClueOffersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionClueRevealResponse parses an HTTP response from a PostSessionClueRevealWithResponse call
+func ParsePostSessionClueRevealResponse(rsp *http.Response) (*PostSessionClueRevealResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionClueRevealResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueCard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionEndingResponse parses an HTTP response from a GetSessionEndingWithResponse call
+func ParseGetSessionEndingResponse(rsp *http.Response) (*GetSessionEndingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionEndingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EndingResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionEndingGoalsResponse parses an HTTP response from a PostSessionEndingGoalsWithResponse call
+func ParsePostSessionEndingGoalsResponse(rsp *http.Response) (*PostSessionEndingGoalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionEndingGoalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionEventsResponse parses an HTTP response from a GetSessionEventsWithResponse call
+func ParseGetSessionEventsResponse(rsp *http.Response) (*GetSessionEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionHandoutsResponse parses an HTTP response from a GetSessionHandoutsWithResponse call
+func ParseGetSessionHandoutsResponse(rsp *http.Response) (*GetSessionHandoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionHandoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionHostResponse parses an HTTP response from a PostSessionHostWithResponse call
+func ParsePostSessionHostResponse(rsp *http.Response) (*PostSessionHostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionHostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferHostResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionInvestigationsResponse parses an HTTP response from a PostSessionInvestigationsWithResponse call
+func ParsePostSessionInvestigationsResponse(rsp *http.Response) (*PostSessionInvestigationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionInvestigationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvestigateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionOffersResponse parses an HTTP response from a GetSessionOffersWithResponse call
+func ParseGetSessionOffersResponse(rsp *http.Response) (*GetSessionOffersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionOffersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueOfferListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionOfferAcceptResponse parses an HTTP response from a PostSessionOfferAcceptWithResponse call
+func ParsePostSessionOfferAcceptResponse(rsp *http.Response) (*PostSessionOfferAcceptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionOfferAcceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionOfferDeclineResponse parses an HTTP response from a PostSessionOfferDeclineWithResponse call
+func ParsePostSessionOfferDeclineResponse(rsp *http.Response) (*PostSessionOfferDeclineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionOfferDeclineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClueOffer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionPhaseResponse parses an HTTP response from a GetSessionPhaseWithResponse call
+func ParseGetSessionPhaseResponse(rsp *http.Response) (*GetSessionPhaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionPhaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionPlayersResponse parses an HTTP response from a PostSessionPlayersWithResponse call
+func ParsePostSessionPlayersResponse(rsp *http.Response) (*PostSessionPlayersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionPlayersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JoinPlayerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionReadyResponse parses an HTTP response from a PostSessionReadyWithResponse call
+func ParsePostSessionReadyResponse(rsp *http.Response) (*PostSessionReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LobbyPlayer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionRewindResponse parses an HTTP response from a PostSessionRewindWithResponse call
+func ParsePostSessionRewindResponse(rsp *http.Response) (*PostSessionRewindResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionRewindResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdvancePhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionRolesResponse parses an HTTP response from a PostSessionRolesWithResponse call
+func ParsePostSessionRolesResponse(rsp *http.Response) (*PostSessionRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DealRolesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionStartResponse parses an HTTP response from a PostSessionStartWithResponse call
+func ParsePostSessionStartResponse(rsp *http.Response) (*PostSessionStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LobbyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionTimerResponse parses an HTTP response from a GetSessionTimerWithResponse call
+func ParseGetSessionTimerResponse(rsp *http.Response) (*GetSessionTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionTimerExtendResponse parses an HTTP response from a PostSessionTimerExtendWithResponse call
+func ParsePostSessionTimerExtendResponse(rsp *http.Response) (*PostSessionTimerExtendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionTimerExtendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionTimerPauseResponse parses an HTTP response from a PostSessionTimerPauseWithResponse call
+func ParsePostSessionTimerPauseResponse(rsp *http.Response) (*PostSessionTimerPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionTimerPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionTimerResumeResponse parses an HTTP response from a PostSessionTimerResumeWithResponse call
+func ParsePostSessionTimerResumeResponse(rsp *http.Response) (*PostSessionTimerResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionTimerResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionTransitionsResponse parses an HTTP response from a GetSessionTransitionsWithResponse call
+func ParseGetSessionTransitionsResponse(rsp *http.Response) (*GetSessionTransitionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionTransitionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransitionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionVotesResponse parses an HTTP response from a PostSessionVotesWithResponse call
+func ParsePostSessionVotesResponse(rsp *http.Response) (*PostSessionVotesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionVotesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSessionVoteDecisionResponse parses an HTTP response from a PostSessionVoteDecisionWithResponse call
+func ParsePostSessionVoteDecisionResponse(rsp *http.Response) (*PostSessionVoteDecisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionVoteDecisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionVoteResultResponse parses an HTTP response from a GetSessionVoteResultWithResponse call
+func ParseGetSessionVoteResultResponse(rsp *http.Response) (*GetSessionVoteResultResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionVoteResultResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VoteResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Resolve a join code to its session
+	// (GET /join/{code})
+	GetJoinCode(ctx echo.Context, code string) error
+	// Recent scenario generation attempts
+	// (GET /scenario-generations)
+	GetScenarioGenerations(ctx echo.Context) error
+	// List saved scenarios
+	// (GET /scenarios)
+	GetScenarios(ctx echo.Context) error
+	// Upload a hand-written scenario
+	// (POST /scenarios)
+	PostScenarios(ctx echo.Context) error
+	// Preview a saved scenario
+	// (GET /scenarios/{scenarioId})
+	GetScenario(ctx echo.Context, scenarioId string) error
+	// Create new game session
+	// (POST /sessions)
+	PostSessions(ctx echo.Context) error
+	// Get the lobby view of a session
+	// (GET /sessions/{sessionId})
+	GetSession(ctx echo.Context, sessionId string) error
+	// Advance game phase (GM use)
+	// (POST /sessions/{sessionId}/advance)
+	PostSessionAdvance(ctx echo.Context, sessionId string) error
+	// Open the table chat WebSocket
+	// (GET /sessions/{sessionId}/chat)
+	GetSessionChat(ctx echo.Context, sessionId string, params GetSessionChatParams) error
+	// Choose the phases in which whispers are allowed (GM use)
+	// (PUT /sessions/{sessionId}/chat/whisper-phases)
+	PutSessionWhisperPhases(ctx echo.Context, sessionId string) error
+	// List the clue cards the caller can read
+	// (GET /sessions/{sessionId}/clues)
+	GetSessionClues(ctx echo.Context, sessionId string) error
+	// Offer a held clue card to another player
+	// (POST /sessions/{sessionId}/clues/{clueId}/offers)
+	PostSessionClueOffers(ctx echo.Context, sessionId string, clueId string) error
+	// Reveal a held clue card to everyone
+	// (POST /sessions/{sessionId}/clues/{clueId}/reveal)
+	PostSessionClueReveal(ctx echo.Context, sessionId string, clueId string) error
+	// Reveal the truth and each player's outcome
+	// (GET /sessions/{sessionId}/ending)
+	GetSessionEnding(ctx echo.Context, sessionId string) error
+	// Judge a personal goal (GM use)
+	// (POST /sessions/{sessionId}/ending/goals)
+	PostSessionEndingGoals(ctx echo.Context, sessionId string) error
+	// Stream session events
+	// (GET /sessions/{sessionId}/events)
+	GetSessionEvents(ctx echo.Context, sessionId string, params GetSessionEventsParams) error
+	// Download printable handouts
+	// (GET /sessions/{sessionId}/handouts)
+	GetSessionHandouts(ctx echo.Context, sessionId string) error
+	// Hand the host role over to a player
+	// (POST /sessions/{sessionId}/host)
+	PostSessionHost(ctx echo.Context, sessionId string) error
+	// Investigate a location or character
+	// (POST /sessions/{sessionId}/investigations)
+	PostSessionInvestigations(ctx echo.Context, sessionId string) error
+	// List clue offers involving the caller
+	// (GET /sessions/{sessionId}/offers)
+	GetSessionOffers(ctx echo.Context, sessionId string) error
+	// Accept a clue offer and take the card
+	// (POST /sessions/{sessionId}/offers/{offerId}/accept)
+	PostSessionOfferAccept(ctx echo.Context, sessionId string, offerId string) error
+	// Decline a clue offer, or withdraw one you made
+	// (POST /sessions/{sessionId}/offers/{offerId}/decline)
+	PostSessionOfferDecline(ctx echo.Context, sessionId string, offerId string) error
+	// Get current phase information
+	// (GET /sessions/{sessionId}/phase)
+	GetSessionPhase(ctx echo.Context, sessionId string) error
+	// Join a game session
+	// (POST /sessions/{sessionId}/players)
+	PostSessionPlayers(ctx echo.Context, sessionId string) error
+	// Mark the calling player as ready in the lobby
+	// (POST /sessions/{sessionId}/ready)
+	PostSessionReady(ctx echo.Context, sessionId string) error
+	// Go back to the previous phase (GM use)
+	// (POST /sessions/{sessionId}/rewind)
+	PostSessionRewind(ctx echo.Context, sessionId string) error
+	// Deal roles to the joined players (GM use)
+	// (POST /sessions/{sessionId}/roles)
+	PostSessionRoles(ctx echo.Context, sessionId string) error
+	// Close the lobby and start the game (GM use)
+	// (POST /sessions/{sessionId}/start)
+	PostSessionStart(ctx echo.Context, sessionId string) error
+	// Get the timer of the current phase
+	// (GET /sessions/{sessionId}/timer)
+	GetSessionTimer(ctx echo.Context, sessionId string) error
+	// Add time to the phase timer (GM use)
+	// (POST /sessions/{sessionId}/timer/extend)
+	PostSessionTimerExtend(ctx echo.Context, sessionId string) error
+	// Pause the phase timer (GM use)
+	// (POST /sessions/{sessionId}/timer/pause)
+	PostSessionTimerPause(ctx echo.Context, sessionId string) error
+	// Resume the paused phase timer (GM use)
+	// (POST /sessions/{sessionId}/timer/resume)
+	PostSessionTimerResume(ctx echo.Context, sessionId string) error
+	// List the phase transitions of a session
+	// (GET /sessions/{sessionId}/transitions)
+	GetSessionTransitions(ctx echo.Context, sessionId string) error
+	// Accuse a role in the voting phase
+	// (POST /sessions/{sessionId}/votes)
+	PostSessionVotes(ctx echo.Context, sessionId string) error
+	// Break a tied vote (GM use)
+	// (POST /sessions/{sessionId}/votes/decision)
+	PostSessionVoteDecision(ctx echo.Context, sessionId string) error
+	// Get the result of the closed vote
+	// (GET /sessions/{sessionId}/votes/result)
+	GetSessionVoteResult(ctx echo.Context, sessionId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJoinCode converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJoinCode(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", ctx.Param("code"), &code, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJoinCode(ctx, code)
+	return err
+}
+
+// GetScenarioGenerations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScenarioGenerations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OperatorKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetScenarioGenerations(ctx)
+	return err
+}
+
+// GetScenarios converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScenarios(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetScenarios(ctx)
+	return err
+}
+
+// PostScenarios converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScenarios(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OperatorKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostScenarios(ctx)
+	return err
+}
+
+// GetScenario converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScenario(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scenarioId" -------------
+	var scenarioId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scenarioId", ctx.Param("scenarioId"), &scenarioId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scenarioId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetScenario(ctx, scenarioId)
+	return err
+}
+
+// PostSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessions(ctx)
+	return err
+}
+
+// GetSession converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSession(ctx, sessionId)
+	return err
+}
+
+// PostSessionAdvance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionAdvance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionAdvance(ctx, sessionId)
+	return err
+}
+
+// GetSessionChat converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionChat(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	ctx.Set(QueryTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionChatParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionChat(ctx, sessionId, params)
+	return err
+}
+
+// PutSessionWhisperPhases converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSessionWhisperPhases(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutSessionWhisperPhases(ctx, sessionId)
+	return err
+}
+
+// GetSessionClues converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionClues(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionClues(ctx, sessionId)
+	return err
+}
+
+// PostSessionClueOffers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionClueOffers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	// ------------- Path parameter "clueId" -------------
+	var clueId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clueId", ctx.Param("clueId"), &clueId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clueId: %s", err))
+	}
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionClueOffers(ctx, sessionId, clueId)
+	return err
+}
+
+// PostSessionClueReveal converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionClueReveal(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	// ------------- Path parameter "clueId" -------------
+	var clueId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clueId", ctx.Param("clueId"), &clueId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clueId: %s", err))
+	}
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionClueReveal(ctx, sessionId, clueId)
+	return err
+}
+
+// GetSessionEnding converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionEnding(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionEnding(ctx, sessionId)
+	return err
+}
+
+// PostSessionEndingGoals converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionEndingGoals(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionEndingGoals(ctx, sessionId)
+	return err
+}
+
+// GetSessionEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	ctx.Set(QueryTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionEvents(ctx, sessionId, params)
+	return err
+}
+
+// GetSessionHandouts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionHandouts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionHandouts(ctx, sessionId)
+	return err
+}
+
+// PostSessionHost converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionHost(ctx, sessionId)
+	return err
+}
+
+// PostSessionInvestigations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionInvestigations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionInvestigations(ctx, sessionId)
+	return err
+}
+
+// GetSessionOffers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionOffers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionOffers(ctx, sessionId)
+	return err
+}
+
+// PostSessionOfferAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionOfferAccept(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	// ------------- Path parameter "offerId" -------------
+	var offerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "offerId", ctx.Param("offerId"), &offerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offerId: %s", err))
 	}
-	return ParsePostSessionsResponse(rsp)
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionOfferAccept(ctx, sessionId, offerId)
+	return err
 }
 
-// PostSessionAdvanceWithResponse request returning *PostSessionAdvanceResponse
-func (c *ClientWithResponses) PostSessionAdvanceWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*PostSessionAdvanceResponse, error) {
-	rsp, err := c.PostSessionAdvance(ctx, sessionId, reqEditors...)
+// PostSessionOfferDecline converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionOfferDecline(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
-	return ParsePostSessionAdvanceResponse(rsp)
-}
 
-// GetSessionPhaseWithResponse request returning *GetSessionPhaseResponse
-func (c *ClientWithResponses) GetSessionPhaseWithResponse(ctx context.Context, sessionId string, params *GetSessionPhaseParams, reqEditors ...RequestEditorFn) (*GetSessionPhaseResponse, error) {
-	rsp, err := c.GetSessionPhase(ctx, sessionId, params, reqEditors...)
+	// ------------- Path parameter "offerId" -------------
+	var offerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "offerId", ctx.Param("offerId"), &offerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offerId: %s", err))
 	}
-	return ParseGetSessionPhaseResponse(rsp)
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionOfferDecline(ctx, sessionId, offerId)
+	return err
 }
 
-// PostSessionPlayersWithBodyWithResponse request with arbitrary body returning *PostSessionPlayersResponse
-func (c *ClientWithResponses) PostSessionPlayersWithBodyWithResponse(ctx context.Context, sessionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error) {
-	rsp, err := c.PostSessionPlayersWithBody(ctx, sessionId, contentType, body, reqEditors...)
+// GetSessionPhase converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionPhase(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
-	return ParsePostSessionPlayersResponse(rsp)
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionPhase(ctx, sessionId)
+	return err
 }
 
-func (c *ClientWithResponses) PostSessionPlayersWithResponse(ctx context.Context, sessionId string, body PostSessionPlayersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionPlayersResponse, error) {
-	rsp, err := c.PostSessionPlayers(ctx, sessionId, body, reqEditors...)
+// PostSessionPlayers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionPlayers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
-	return ParsePostSessionPlayersResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionPlayers(ctx, sessionId)
+	return err
 }
 
-// ParsePostSessionsResponse parses an HTTP response from a PostSessionsWithResponse call
-func ParsePostSessionsResponse(rsp *http.Response) (*PostSessionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostSessionReady converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionReady(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	response := &PostSessionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(PlayerTokenScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateSessionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionReady(ctx, sessionId)
+	return err
+}
+
+// PostSessionRewind converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionRewind(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionRewind(ctx, sessionId)
+	return err
 }
 
-// ParsePostSessionAdvanceResponse parses an HTTP response from a PostSessionAdvanceWithResponse call
-func ParsePostSessionAdvanceResponse(rsp *http.Response) (*PostSessionAdvanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PostSessionRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
-	response := &PostSessionAdvanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AdvancePhaseResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(HostTokenScopes, []string{})
 
-	}
+	ctx.Set(PlayerTokenScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionRoles(ctx, sessionId)
+	return err
 }
 
-// ParseGetSessionPhaseResponse parses an HTTP response from a GetSessionPhaseWithResponse call
-func ParseGetSessionPhaseResponse(rsp *http.Response) (*GetSessionPhaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostSessionStart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	response := &GetSessionPhaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(HostTokenScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PhaseResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(PlayerTokenScopes, []string{})
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionStart(ctx, sessionId)
+	return err
+}
+
+// GetSessionTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessionTimer(ctx, sessionId)
+	return err
 }
 
-// ParsePostSessionPlayersResponse parses an HTTP response from a PostSessionPlayersWithResponse call
-func ParsePostSessionPlayersResponse(rsp *http.Response) (*PostSessionPlayersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostSessionTimerExtend converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionTimerExtend(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	response := &PostSessionPlayersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(HostTokenScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JoinPlayerResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx.Set(PlayerTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionTimerExtend(ctx, sessionId)
+	return err
+}
+
+// PostSessionTimerPause converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionTimerPause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	return response, nil
-}
+	ctx.Set(HostTokenScopes, []string{})
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create new game session
-	// (POST /sessions)
-	PostSessions(ctx echo.Context) error
-	// Advance game phase (GM use)
-	// (POST /sessions/{sessionId}/advance)
-	PostSessionAdvance(ctx echo.Context, sessionId string) error
-	// Get current phase information
-	// (GET /sessions/{sessionId}/phase)
-	GetSessionPhase(ctx echo.Context, sessionId string, params GetSessionPhaseParams) error
-	// Join a game session
-	// (POST /sessions/{sessionId}/players)
-	PostSessionPlayers(ctx echo.Context, sessionId string) error
-}
+	ctx.Set(PlayerTokenScopes, []string{})
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionTimerPause(ctx, sessionId)
+	return err
 }
 
-// PostSessions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSessions(ctx echo.Context) error {
+// PostSessionTimerResume converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionTimerResume(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
+	}
+
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSessions(ctx)
+	err = w.Handler.PostSessionTimerResume(ctx, sessionId)
 	return err
 }
 
-// PostSessionAdvance converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSessionAdvance(ctx echo.Context) error {
+// GetSessionTransitions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionTransitions(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "sessionId" -------------
 	var sessionId string
@@ -787,13 +7116,17 @@ func (w *ServerInterfaceWrapper) PostSessionAdvance(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSessionAdvance(ctx, sessionId)
+	err = w.Handler.GetSessionTransitions(ctx, sessionId)
 	return err
 }
 
-// GetSessionPhase converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSessionPhase(ctx echo.Context) error {
+// PostSessionVotes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionVotes(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "sessionId" -------------
 	var sessionId string
@@ -803,35 +7136,35 @@ func (w *ServerInterfaceWrapper) GetSessionPhase(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSessionPhaseParams
+	ctx.Set(PlayerTokenScopes, []string{})
 
-	headers := ctx.Request().Header
-	// ------------- Required header parameter "X-Player-Id" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Player-Id")]; found {
-		var XPlayerId string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Player-Id, got %d", n))
-		}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionVotes(ctx, sessionId)
+	return err
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Player-Id", valueList[0], &XPlayerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Player-Id: %s", err))
-		}
+// PostSessionVoteDecision converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionVoteDecision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
-		params.XPlayerId = XPlayerId
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Player-Id is required, but not found"))
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSessionPhase(ctx, sessionId, params)
+	err = w.Handler.PostSessionVoteDecision(ctx, sessionId)
 	return err
 }
 
-// PostSessionPlayers converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSessionPlayers(ctx echo.Context) error {
+// GetSessionVoteResult converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessionVoteResult(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "sessionId" -------------
 	var sessionId string
@@ -841,8 +7174,12 @@ func (w *ServerInterfaceWrapper) PostSessionPlayers(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
+	ctx.Set(HostTokenScopes, []string{})
+
+	ctx.Set(PlayerTokenScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSessionPlayers(ctx, sessionId)
+	err = w.Handler.GetSessionVoteResult(ctx, sessionId)
 	return err
 }
 
@@ -874,30 +7211,188 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/join/:code", wrapper.GetJoinCode)
+	router.GET(baseURL+"/scenario-generations", wrapper.GetScenarioGenerations)
+	router.GET(baseURL+"/scenarios", wrapper.GetScenarios)
+	router.POST(baseURL+"/scenarios", wrapper.PostScenarios)
+	router.GET(baseURL+"/scenarios/:scenarioId", wrapper.GetScenario)
 	router.POST(baseURL+"/sessions", wrapper.PostSessions)
+	router.GET(baseURL+"/sessions/:sessionId", wrapper.GetSession)
 	router.POST(baseURL+"/sessions/:sessionId/advance", wrapper.PostSessionAdvance)
+	router.GET(baseURL+"/sessions/:sessionId/chat", wrapper.GetSessionChat)
+	router.PUT(baseURL+"/sessions/:sessionId/chat/whisper-phases", wrapper.PutSessionWhisperPhases)
+	router.GET(baseURL+"/sessions/:sessionId/clues", wrapper.GetSessionClues)
+	router.POST(baseURL+"/sessions/:sessionId/clues/:clueId/offers", wrapper.PostSessionClueOffers)
+	router.POST(baseURL+"/sessions/:sessionId/clues/:clueId/reveal", wrapper.PostSessionClueReveal)
+	router.GET(baseURL+"/sessions/:sessionId/ending", wrapper.GetSessionEnding)
+	router.POST(baseURL+"/sessions/:sessionId/ending/goals", wrapper.PostSessionEndingGoals)
+	router.GET(baseURL+"/sessions/:sessionId/events", wrapper.GetSessionEvents)
+	router.GET(baseURL+"/sessions/:sessionId/handouts", wrapper.GetSessionHandouts)
+	router.POST(baseURL+"/sessions/:sessionId/host", wrapper.PostSessionHost)
+	router.POST(baseURL+"/sessions/:sessionId/investigations", wrapper.PostSessionInvestigations)
+	router.GET(baseURL+"/sessions/:sessionId/offers", wrapper.GetSessionOffers)
+	router.POST(baseURL+"/sessions/:sessionId/offers/:offerId/accept", wrapper.PostSessionOfferAccept)
+	router.POST(baseURL+"/sessions/:sessionId/offers/:offerId/decline", wrapper.PostSessionOfferDecline)
 	router.GET(baseURL+"/sessions/:sessionId/phase", wrapper.GetSessionPhase)
 	router.POST(baseURL+"/sessions/:sessionId/players", wrapper.PostSessionPlayers)
+	router.POST(baseURL+"/sessions/:sessionId/ready", wrapper.PostSessionReady)
+	router.POST(baseURL+"/sessions/:sessionId/rewind", wrapper.PostSessionRewind)
+	router.POST(baseURL+"/sessions/:sessionId/roles", wrapper.PostSessionRoles)
+	router.POST(baseURL+"/sessions/:sessionId/start", wrapper.PostSessionStart)
+	router.GET(baseURL+"/sessions/:sessionId/timer", wrapper.GetSessionTimer)
+	router.POST(baseURL+"/sessions/:sessionId/timer/extend", wrapper.PostSessionTimerExtend)
+	router.POST(baseURL+"/sessions/:sessionId/timer/pause", wrapper.PostSessionTimerPause)
+	router.POST(baseURL+"/sessions/:sessionId/timer/resume", wrapper.PostSessionTimerResume)
+	router.GET(baseURL+"/sessions/:sessionId/transitions", wrapper.GetSessionTransitions)
+	router.POST(baseURL+"/sessions/:sessionId/votes", wrapper.PostSessionVotes)
+	router.POST(baseURL+"/sessions/:sessionId/votes/decision", wrapper.PostSessionVoteDecision)
+	router.GET(baseURL+"/sessions/:sessionId/votes/result", wrapper.GetSessionVoteResult)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xWXWsjNxT9K+K2Dy3IHjvbwjJv7kKDCynDbimFbVgU6dpWOvpY6Y67Jvi/F0nj2BlP",
-	"Gj80S9+E5ujq6Bzdo3kA6Yx3Fi1FqB8gyg0akYcLtRVWYrMREd9j9M5GTPM+OI+BNGaUT5/TAG1noP4I",
-	"2lJwwEHbLUbSa0Ha2flw4go4KB1lF6N2FjhsHWm7Bg5oVRrccqCdR6ghUkgT+z2HgJ87HVClfcrGR5i7",
-	"u0dJsOfwLqAg/IC59Hv83GGkc+JKr1Zadi3tTtmjiDvgYFDpzgCHjQhqhAsH34odhneus3Sy/gf+4xGs",
-	"LeEawznzk6X8lMcFh3nOhlgAS5XJfBHGt5lvmf40v3oDLwl6LDHG4xenbZOJP6toOdevwuBTEmUZW7zI",
-	"4KTASxSevY75+1CGMvtpDiNGBtfiGX5+IdelgscKY5RfaJ61+Q2/ZCXP79dX6ysOPuitIFzalUs72q5t",
-	"xV0SgkKHY/jurtXyQvho2/LD0c9FSwt0X1phlEH7dDCo4eb3ht0J+RdaxRbNkq1cYIvlZI0WgyBUzHRB",
-	"YWBmFwnDjq2FwSn7WUickJushESWXOPMaKuNaBla5Z22FKd/JqlIU3b/ppS56cukbRfNMmmJIRYqs+l8",
-	"OktSOI9WeA01vJnOpqnJvKBNNrfqG6rcS1c6JrmfjUpXDhoX6cMBVXTCSD85lTNJOktY4kV432qZF1b3",
-	"MVE4RHUafRtwBTV8Ux2zvOqDvBoNw/1TV5JveaLc00z4ajZ7LQ59N2QSTx3uIUzmBSpfntgZI8IO6j4K",
-	"mcW/s7Ws1zejHsWuHh5zbF+J8oRdZED/3GUHgzBIGCLUHx9AJ2bJVeBgc7idROVQRn4iybARbl9R4tHH",
-	"ekThDGC9LkOB+yJF3dyo7LvrG9ZF/P5fRH5MqjWOKHyNB4GbvvNfS17e19qgUBiO1f6YlEdj8j+y60Kf",
-	"Ug4GkzcYWHWNxGQXAlrqnRpinzErS3FZJDU99pU74r/PvPN/la8ceCN/KmMel/+ie6ftWSumCkwMcy5B",
-	"MGwPPnShTfedyNdV1Top2o2LVL+dvZ3B/nb/TwAAAP//ukbjbdwLAAA=",
+	"H4sIAAAAAAAC/+x97XIbt7Lgq6Bmtyrn1o4p2XHOOVf55diO4ySOVbZPcncTlwqaaZKIZgAeACOa16VX",
+	"2J/7Z19kn+g+x1Z3A/NFDEkpx0rk+JdEEh8NoLvR33ifFaZeGQ3au+zkfWbBrYx2QB++kuUz6WEtN/ip",
+	"MNqD9vivXK0qVUivjD5aWXNeQf0/fnVG42+uWEIt8b//bmGenWT/7aib4oh/dUen3Cu7urrKsxJcYdUK",
+	"h8tOsjdLEK4ALa0yYgEarPTGilKVQhsvLPjGaiFF4+R51TXNrnIE+BX8swHnbxPgMKVQTtSymhtbQ4nQ",
+	"PDZ6Xqnid4GlCHM7sVZ+KfwSRNFYC9oLB84po4Xz0gPC+bWx56osQd8moI9lVYHFPcNDlVVl1lAKb8QK",
+	"LG6h8EvlhCyo+VWe/WD816bR5e1upjONLYBAnNPsV3n2Dy0bvzRW/SfcKjRfgbRghTcXoAnXlHNKL3Kh",
+	"9KWsVCmMFfBupSxi31UeJiRCflReSl3A6VI66BHIypoVWK+Y2ufGFoD/lDCXTeWzk7msHIzB+B7kJRBC",
+	"rXA4AZcIzlwo78SikbaMZ1qDz4VriqWQLbDi0nhwWZ75zQqyk+zcmAqkJnjDV+b8Vyg8bvQQauZL22AT",
+	"FPgP6KbOTn7OKnN+vsnyTGlvDf29BOfVgo7l/viLB1melcoVDRFFlmeXxiu9yPIMdIn/vG0hc97iFwir",
+	"hX82tNEnPwcA3qYW4JxaaChPK7kBmwCdvn9OWDSaIw8//iBrSP5sTQX7kOmVqeB1U9fSbrahjnMPZgrj",
+	"phbzWDr/o/HT+COLonFQ4qS8JHgn6xVCma0eZPt2cdg7CUDVwGNpy+2Zl8p5YzeMu31c/WlpxBKqkvkf",
+	"4qZTugCxXoLOhalKcF7MlXV+Jl7qahMuF2ZE2GdpnJ8hynio3b7tRgC/MVUZTigsQForN/h5ifOF0x6C",
+	"yfghlty3BXZ7z/JMjXa2uJ9qpY1+Y6V2c7B4R/YQqCW4fJtw9hHK28RMFi5BVlCmp/DSLsAn8Zd/erNZ",
+	"DUCoDHPPLM+KpbSy8GCT83p4NzGu8lWKYkbopsos7sAAlvghiyOFqXrH11v09k5PIW7Ei+vxAMLWwJtr",
+	"6bOTrJQe7nlFpLqHLXUEzsNMgfa9cn6avRZVw/8cTAJEo1v4P4KOh50C6eV8nuKX2GlipwoL0kP5yB+6",
+	"W3k2t6Y+3bX7Zj6f/M2CM9Xlzgl1U1VMfd42kADAeekb10f/VbhzcmSHsPKEYiUUldL0L8pypZVrnaYJ",
+	"s2M5o/2Pa8vjpo72YzBaC2p/n3ee3W6cosmvh1Q06l6sCgPvBG3y/rrO9vXaTk32puV9w2ks1FLpwAtG",
+	"MnHVMOdnAYpOWmzAC+lZHO54E8+otIdF2Jd2upG4Fjiq0LKGHGXElrGK50/EfPAFD4Ln3N0w//V//99/",
+	"/Z//nSKg38TCxxuaZMHdXiV3mXDxNaszk8caxPNXgDOTOuESO8+N6Optdc+6cV5UJO6axvelgLhWbc4W",
+	"xhKxmzMH7xpZnYX54nfV/GwpbZ0m2JGEUKr5XBVN5Tf9SUC6TZZnNZSqqfEiQv6aGg6sHAoH9//9wTEe",
+	"Zi3ffQ964ZfZyf3j40TPSupFIxewvTFfPT4VD/8mvFwIM6ftiW1RZxN4NzohLYi1Vd6DFkoPsOdXmcIc",
+	"vpsem0b73kof5l+8TaG2NRWwKF0HPavVULJfjdJnxpZgs7Gi8o1ZC+zK8C2lLqHEg5yJrpOQNK7DldWo",
+	"pzBkjprkwi2b+bwCIXVJomBozq1RiGyFRFGCrBzPN/uF9iAc3wDCMCCJE84nTzGiX0pQfAUIn5DCyUso",
+	"O0xV2nmQJR5RMJigHCmFhrUwGmait+G56NCMFib1pu1ltJgrqEonzqEyayaBWvpiOSCNzxxqdzIXxi/B",
+	"rpVjhdB2hhALSKVQzn4Z4kMc4ez+g8+TPGUJNaQEebA8R71xHuxGeHkBdFwFDCaopQ6K3F6s9wq+siAv",
+	"hhhlAXXUJDZJ4RWUpMPiGh14X0E5E9xFFBVIS6hETRztbvhvIRGfmhUrFhZAWLQnOKG08MbLSsi5ByvW",
+	"S1VEc021ssoLcIVcgcvFohYVeNeh3EoVF0LWJqgMBFtAeF3G/mehv2AFC2+Wc1NuhjjarnmBDGbUM826",
+	"VA1238X9Bhu9Bo/Y6KiX0YO7ojDI1YoszxxYZRp3gLbdYx0DjnnADTElj+BujlVW/G4KSfG3N2iGSfDL",
+	"vpGGTG/PXgirFkvvWluIJLtG1PRaJWQ8CzKOx6aEIVzf/e3Ff3x+mmof7HrjhYSv02sZbW43RG/6PO5P",
+	"f+Gp3X4CskL93U2bCFouzh/LUuG+yep00GxracM9jpoN0pIle8FMvAqr6LhyWIsgpO8xb5x9lm2Bf7V7",
+	"QZPWJ74vDpZlRyahfQJtHD613U9JV5gGbVG/mVKSrws1Q/uy8UiwKcnF28Yv943yhhpdkYmN4JVV9XKe",
+	"nfy8uxsbnRxy56u3Y7VqvGFh0RGifPcOvvOgS+JSkyjroDC6pH9rpVWNjOv+tpSyRUjcKzXrs/aufeQ9",
+	"1KsUnXQ/DFH/mAR2RG+yWglvNzl+1MJoECuwwsJKKptUEcBaY7fHfA2+o5rO36I8Cq9iLhVbOraQ6FKZ",
+	"Srby9EF49GPsshfx4w4Mptm9m9+wFXCaICwUxpaHQ9sN/Yp6phCftxsSsho6OPCylh4ZfwFA4icaGfmm",
+	"l6RbOE8nt+PU2s49Su79TMJD6qctjRXb9YfrAZ+3e7N7i8M+TOHrTbY20kBib52X9po2ndRm9X0MAxpt",
+	"hx/uSruY1F48b02i04bwD2X1PEBl3gvyFG2wo+3UKO3d9zD3aWRDW9HhJsCEyS/Lt2dKwfxtED6mAf6j",
+	"SEdT0POVOYkjQ+9OBw93E48ONO/SAPtA2C27bPlq6NuzpF/h2k4n7pJSZX9oqko02qsqqUNvy3GlgeAr",
+	"7ungRpOmPhuogKv7h1hf/QECvCpBezXfsKyuonlAqAFo1zHF+0np+dumXMAzI6sdDralgsspX4tN+d3u",
+	"74UtdMu70VPAfafNuoJykeIc56bxhzr9el6nkQsMv6ZNVZpZPZ75ORSyBnGpnMJQD6Wzw11BY4GiB2bP",
+	"84N9Uwv+Hp3IU35b5b4xzk941X6LTxdkuZk+3Q/i7qUp87ikya04RHneqcFu/WhWoBHiw4WGEWcZiwu3",
+	"HwiwZcgcs7j6HCwa5aJVcWBe1gBkZ5ubYNpaIK4XkiJzbNrKf13FrY/GKel1y7J6Y6tl/3q9qVN2wvwQ",
+	"HbGRaFtzxNAWNFpMt1l9XEsh+J4Ak5RoNDzoR9RCrKhJcN9TdNNSOlHB3Od4Ywywjc0+jhSC6dtqJHq9",
+	"Ce6ZLQDCD6xtIAadQ286KPnCUo5nvU48A4+cwp0d5oWL/m0xNuRK39uhz/i6DxslKfSCODVbloMsEM2m",
+	"XQzbtdbRXV4prgHWoQUKb97ExvYhNWstYnOxMLLKgyrXEi8uI6gVh4ggvwvHsupSeniu5wZn3g9jc16p",
+	"4uDmN5AQHRQW/AF7zw1/26YnY7daZE5yh4HtayrqKYXp4JcBTnIPhJZ9QTKgfyIeLs8QwR71JL6xO8L5",
+	"z5z4FYVGZHfROzfCT2OF7gRtar1jk3qzK/eYvQATUs6IaiYuxlsKbevjUEI8lPVPRqecaWhTbDcuHohT",
+	"JeSihEKhweZ80x5g8pTWqZH7RyeMLiDs/MBfFsDK95kppiS3sOTRWfRPLm+Rs9uFEV7xApJoHwJRt7ft",
+	"68fib38//ptwflOBIKOiQJdSlo9Iowii37D/C1Q0yOQlS3nejoCNkcufW6mLpTB991QIcD0L/sUsz5p+",
+	"DG4rfZxp4884Tjdu1uCrOA5R/Zm3UjsV7C5ra/TiLLID4sPUNcrHcYZ5U+Emz9uQabZmn3kFZWvcOGNJ",
+	"4AzeLWXjAl8yZxTzdIbyQAi7GQDXfuH78WQhCmnQkr/pYoX4c1GZcNSqBnvG0d0MnTsL4bdZ515Obkz8",
+	"sd+ucw2ftbbgft8a/NKUNFyI4qYBPVgtqzM63ORFVIKXqkpaY3rx1rvDpmLHh8cPkzExUezsZvjBePH1",
+	"1Mh+sxo1J1nk5LyS+mKvJu2DJS5Iq13I1JSZ6BXi1qS2P6kMjqbldsnxezxyW+Gme12spCUWKLsooFw4",
+	"OSdadEuzxr9wCXZjNGxRuDrA3pBneorRs2xxas1cHRyzqZn7DbumVv86IPDuULSI5oerVHHcSU10BHU3",
+	"wy4o+xAe5hbbhmO8tvZE/2Uq9kQsVTv03ginEKK01Q6cVzVqKuiMe6F04wee4B5NK12QVe5JH5vf7w5p",
+	"mo4y2f7F6PQPa2Orcveko4NPrikxUHpNef/4tjHnbQ93ejQ+QoDrB8UOQ9D2hp/vC+fBS3ivdSRw/VaK",
+	"jylLyonehTRlDGmH327A2Tt9BStcZnRHNavKyBLKifiWw8PIW/vEVGhKC0l/Q/bF0ga39KRVovEmJMik",
+	"RfSy4Vv7defAHulXqgZxjoJpJwSTFVbpomooEwHQQU5nkzwA0KV75Kc8yqqCEJdUc6KXbbRmIeRm8dKt",
+	"urz1Sxsn2ltsAh1IJBqEb9Kd1sG1kkFcjnlUKdRYSzuaasz/enPuju4I4maU1cZHllhYPjj4LWAmEamN",
+	"wpqyvHfI4GbilI1TKKoKNMYgikjhltJCRJVeKGDL6EiJoqG+FMfCN5YDKwMGmPk8RC/IYMCZCRK0KVyN",
+	"pVmxxGhbbUIX/AHnHEYT7iSE3clroWE0J2l4F0Dp/E20E7bRLgT9TiTO9C6pqTCmNl7kOEk8kYm+7xtx",
+	"Tu5/sWX2OXlwnIpO2sbDdu0/f358nP/1+O12QmNAJ1plsBd6qxYL3GzBmkMYlxL7BlHPu+JfEpi+jYhB",
+	"sUHbxR7H6OFOySlt+e1eAK4TDXjw/KHz5Owqyg5bNmaTTA3DDLZaloGTtiN8yWYdIieilO4ndwgrldfJ",
+	"kzG2mHI6Ys5I2gSsdNlntdGlIVvOZWGtdKuvTiS07L+BCQJqGiZtAaZV7j6I3bpBf08PFaC7sfdy//7w",
+	"aTBDTN1IqGPrzoRpjbXx9E/Gq8up+7P8BqyNF8Th8jyeXaX0AZJSB3YLSQttb5whLKlt6WLItv2y2nnK",
+	"GJZ+OaTfo06YPjo+ak1n22gLm3WIcOo610qHQPI8teHOycUBGzAArpupGyG52C72cX++7dgpRT+Tgbkz",
+	"BLfX3DC8/CB3xW7MQ4upUylzKIbO45SyB5FYSyeKpXGgZ0KbMw6Tr0HqGKFONteSRIBteEc5FrX81Vjl",
+	"N1MR7GR+oyl2eHHdT0pPOd9D4YHhun4kdwsH8odAP84IaE3GuShQ1qdWMEp9H+RuVdXmgIDoXpctSICn",
+	"EhewYbM17fLzJ1kCqxiSmwdg91ELk8jaOXkjk7NuhZ2wDa7dFNqCHhblA47RO6EUmfy0VG4FlsXWiRIB",
+	"Q852ix63/SpAitGxU6NBrH6Nd0snm0xkHtDXXQL7+Uacvnz9RhxF7XqWhZIQhNwgLfTiTZfer0JQBkX/",
+	"fgebtEPu5enTV4/evHx19t3T/xlioOwl5q3Iofo+E88aSmQchDxU6txKywJ/F2kMumSD+Uz81EV90bDo",
+	"29MGEUx4ZBZdWyG1W4MVD48/Z16AxJstQXLIAtscs/+49zKs6B4uqTuMlcLPLe3fcE+P3rchC1dHAUcP",
+	"2ud/NmA3E5M+Vew3xF9zTtCsFOiee18bYjWCV+tm4k23YxZKWXgnlBcoGlFZjpiXVZnFLO4UQdBtlCwK",
+	"cO6MJt3episyvM3NNrAvfjwV57K4AF2KR6fPCdxHz++1dhZRN5RnF9O20GM7E1/LAu55c28uC/a85YKU",
+	"C1n1kOEX3dpWTrIXPMyLMAxO++j0OVIgWBdi42f3Z8cBibVcqewk+3x2PPuc9Hq/JPo5wrCSo/eFKeEK",
+	"P4c4XUb7EL+SPQP/bRd9spJW1sBW1J/f8+at+A4Pe1dww46s+f7sCr7sj0/FxIZB6aUHx8c7astcr6bM",
+	"VjBtorhMyJHqqtw8PH44NW4L6FFblOeKYq+DGZLq5lSXICQFZ7auPUTFQC/U4SjyhXudj8n1TmWb+1TS",
+	"eXH/+JjiAlzU4reLRQ1re+RdDaQumYCJiVQrkJiXxVHfQroLQmIZYvJn4jtYeaG0qKE2dkMRO7lwhloQ",
+	"y4uJf8q3eUjM9yI3Rf7F6LyFaNGG+6y3Ax8QFaazJRI40TUWscIKIcb9/YgxqJFEnT7f36mrQ9W//Yjq",
+	"BvfSz2+v3g7RrQDtt9AA4W5j+Qf4No1kz1q+hVdUtBC3Q7scU2rHRWNq8LKUXnLeK98VM8EOtoApFN5c",
+	"SB1yNjtYQ/gfmYI5ZzdQyB58+aBYknSdpZjGIAvZjbgA9h4lKlMS6CrEzu6q+maIgqxpFky3TtYgGDw6",
+	"GQe11F4VgvzW4ayd6K6ddsaZeIreSxHKcTGDw5OqlGsj40CwNzoaN8f+cI5R2EHdX8Zj42ubU84pWD3U",
+	"8aBQ5sYBZlsbzWGedOiw+8hPjRudOV3lX5lyc63jHgnw3VbPDTrtEfWizz6gQUstM/48o0G35dSr8c13",
+	"dQuY2YU5byNlXBmhHjOf4/3Mp1c68A/J5P5BvAhZidTlvVhtoSt+OOBvR++7KgJXk8zuFbCJfk0G+RCi",
+	"XMuNwPjNrcBkuurcCX3h2JtAqYdiFSIJ2BePJEQXaldVJKrwPb7cCVV80aLfAGV9b7jaAIu0lMOZh7g/",
+	"lw+D2/Azuy64BgWmvFdNqPYQPRdfdsklIUAW6iFjCWSLu2oa8n3wcip1AWPlxfk9XPkgcbE7mp1C421K",
+	"iFtRCLvo6l8iIp5auFSw3iplERA56Fc4fPq66Pla6ej7NS3wPl1CcQFlRGKpN35JPK53R/S0zU4XvQBY",
+	"xVscO7QkxfIiRkD17hyyq+F3LqTL5oM6GKDLUH/zi+MHYjqiapLxx224Od/fGd+dqp9zyww9XaFhh4IS",
+	"nOY35OtfHD84qEsseztEWgaWqqowSxwoMwmbwCTrRdmIi8wErylxwsAnOejdeVVVwqzQQProUiqyzfbr",
+	"ElL74RCzJHNqs9QO4E29DIw/BmsaZh8lEIMaCGQmt3Zx34j19W/6nhnv57cUuNW3Qo2v/mfAKRNVu1KO",
+	"2NuLfkey84un2SjpL/3ECkIslAFCV7yIJVX0YbSrSo7ZaZGQEyW7RE6yCSvX1lu6JFOWkAFFZ+JRW/2E",
+	"7FPBVI6f+TqnONauis6XAkV74RWIRpchnj4U3LFNCDEJRXZsqMHTcxv0ih1vXBT2+/OlivLk+4rxzMTz",
+	"+dBNkWjEnD8atFsnB4s2BD8qAVQR4DwsO0SLA2ssoSqodDxHLhpdgXOC/JuhAFGI1WAvcLikbKMpaoPm",
+	"74VHCFmt5Sb033PndBEmH5Rp/OsvtVSp46twqX0gFpWsU5zgVHxSgbDKPy63wg7/vr9DW978N7K3iJ10",
+	"pTIP+MuzF6Jx8G87WFuxlH7yev3HamFlCY45z09w/toUF4AWGGspmTvoLDhILtbsPcJcTL8G0MKvTa+Q",
+	"1rMXQmptGl1Qpo2bicfBHu9Al+LxUnr+4mskFMGKMXe1UIC6BGrzmuzzgzZoPxKF0RoK3/d6sELkAh9w",
+	"3iBHC27ifnoh8mkLMgRMSsxXFwuSUSjKSjMbm9JZeC8RtA9I5fn4aJ6XXSFB54erol0UssI1kT445azA",
+	"ZWX9adtIFqX9Xx8mnKxbMsp9Jr2RkLlWviBFIchZHeqsrPGmMNVHJGTk7wd+qDFdvlzFiDiSPJFWuv3Y",
+	"Q5lHgabuda7XVZNwtZw2EQ+HLtw7d+0Mwb9lJSoxeeLmIfGHRZqW40kL8e2FP7Kl7JYF7sdLY1zvjYM9",
+	"O3fQdRXLaCfvq9OgAS7At9XfSQTekMgtVlUTbwO+uahUPRchp8YsVHZ2Lm6Lv4Qqws6Iudx3FVTNhye9",
+	"D2VHGBcyTz050pZX/lgRl1wufJU2PSyK8gLqG3i37sPTo/dcFPzqqKvUvUt/7CUshklDDQLqjpoLBXLT",
+	"b/QTRXlzxToO+6aGQvoQhDwTVKqbQ5AdwkwpoUFXRfEs2kyUvqQ67K3CRy827NZt2lLg7sMKPomx2mLr",
+	"v/uFtVUP/bYNf11R9/QTUIZ//Igupd+sV+2kfaYYye+dtPRPGpDmYhrc/XDi5xumT/w7SeoVN78rJPUB",
+	"8TqUv0u8JxWv7Hg8d+ci2ol7vLAk8rW5w9Nox3fApHB0qPGbbWdDp164O4KBLlw2IffoEfoePcg6H1jv",
+	"1kqH6nPBf0+hrjhdXIqAykGvXb+3ioW2y5l4irdWV+iDy02wv72r4p0o4qHs0NPJC1PhgbkS8vayQ+jj",
+	"wK0JVu+W8p7GsgF3Ucwb1TxOXR3sMyb7quGiLbRdpIyyRZU3zH0ywA2p10ePOyP7AHlNLP28h4iPyDN/",
+	"TX9DHu3yQ/o8HXj7SeGaW2B/P4VV9epGFqrkmpF00RHs5IiIRQ2ZrNxMYJVFjrPi37EuPzkpMKIiuB1A",
+	"2kqB7ejyS8HJStjRNJFMB+D2iv/XqBfuEUEZj5/RZt05W8dWpcqDRMeH29jwbcv4uPzwR2aEuGUypt1E",
+	"R9vg7jjANsFa1uT9y4bre6/xnJ5esuHbW7x5zDwi/1LqBbicbmSX956/CNmJqrjA0BquPRbUuu5xSA7A",
+	"k5WPyUr8FJ8MzrfAtskcgs+SkHkWg/Gi6ZzM399L5+8RfPeeP6GnAYLx3S/jjPQKJcf8BVM/203IEv3g",
+	"i7/GZn3vKvGdi+0Q4BOGJ8b5sckeBQUu7t2Z8iluqr2yuUdpzWqFejF6/YirrECHXXU53/dyIMY4IIGk",
+	"hdAK15y3p0Q+PeHUO7E0DbK5r6xZO7DuMz6x1/yMaZs6oMuYO9AyUvbjkue3chiXKlaSNiucTz9BQJDZ",
+	"uAvi2iNwMHp9eL1gnPwxwIjst4kseOkwodzjYxqyy/GAW2IJgRFOOEiGNBq5pBQRi1BkKAnf4xrEX0Le",
+	"MQu6eaAELkqVE7GcEd3kdA+eMRmWITCu+0gVqgrpfPjXK/y2XyAyJ6H9LNoUw8fWfEOfopmn+ya+TJeH",
+	"zPFmVUrffQyJ5DkxorNYAs0r6qhK/BDMqVx4y3UQz2azfwtGo29fv/xBUHj1Sm4wBnLWH6LHK8ANDEB/",
+	"Gj/Na2bGkVsEbj7N7mOo47TCJf7X89MuZ+LZC8G/ir8s6tnS1xW+RTTz7zwfkdEgzo25qMDzCxQoWP1l",
+	"dT80Xd3HpnykM4HZqrG1Y+M2ztEFi4Yw0ryt9ji8zjrRFDHmMydCPUuxpKQwo4UD5DMe+dciRlt0S4jk",
+	"xgZyBPUzF6bqArBi3KnpbI5tAH9p1poicZXfzfa+idv8R9G0/lOthkyrdZqeKy3tJsv3s7F2UXeUugaE",
+	"8yQe5coqzU7OljZ2kE9QbKbTGAaxVDii4zAoVddQKumh2oTY0xgVFfOZlsrlqOhTKq+jt6JYk42vvhRS",
+	"lxh7CoNgQVGbS3BRbIjJ0CjGjCMMFxYgpKT4YK7XJHxTDmf/QbvPnCjhUnGgUbXfqE7lw++cKpMqSXLL",
+	"hvBkUZKU96qPVV0JXNvXgj55bwOP6ng20cc4CnEHeQ/yvXdYMl6vKLrbaBSNu/LfW4W+76OsPkwiR0jQ",
+	"K4tGCOQ3dc9i2sZE8oMuHNAXIg1RD2jfhOWLjfpwtpQZltXGKbU3dvNlKKW9ktarQq0k35PVhgtuxyBH",
+	"nm4tuWeUC/cQ/fPhZt058k+85nPL1J96nGfKdU1n/8lCcrBXore3Qor4yNLgCeQdjKBzfSeF5B8GeaBt",
+	"9IWDVrikAUZPxewK0P/gLum3t+HO3ReEEVb5MQdgEDdn9AnxCfGtT+bOe5Hu6H14nv3qiPNHD/LB0s4+",
+	"4va37YTtnpP/g6HjAdEFfz7GyEgiZA9TWZ+QF9DGn10HS4Mh6HA0fRI6fMLTD4ynfyCsC2c+QDtKoERL",
+	"E8nDRoPYmIac3DvQr60DuzOruatnG83n4S2Hzp0gzgevNaPs61wDJboXftEvYzjB+JUR1NDJSB6NSX78",
+	"Ugm7MdisFL3PvWc5ftH43To+hXPzF3Bmv+joUyELqZkPeiknNJDlgSr6psX5TgA5DeVo76L8cWDyTe9x",
+	"uY8k9AaTAwc4MVzjNBF1L4ntZdkhPPkOeqq3HuK8ZfUu8QxnCjepRTDW3VjB+/CsvnM1G4XGy8OSoI/a",
+	"lyz2Ihq9iHH30GzwkMctY9jghb9UyCGmUs0ruRDBO/fJgHAwb30h7UV7uVOoDxMqhxWWm6jccxHJXQSw",
+	"DuWQ07bEf2h63FZifALK4LrLvn5mcF6ssNeZBkPckQWzgiDohOxh8hV0xc4oCkOhDyIkiBCgMQzgHISF",
+	"e6A92L2GvlexWPNdlA2ul59rYd3h1qewouyZYfQL8ufKwqUyjTs8U9fGR14nDOn8uGh895kt4tUGFQM6",
+	"chjEysr2gU+XixLa/JGBlS08q9c9BhoKta2M44eE07WN9tEArePOXU5PQFYE+u90QfXmnyY9asAxYJ/u",
+	"p5vTKu51oKNAriMH8AHkSrSwg1yboqCHi8mF1LqMdfuaz2hGKucFbvxmSK9+Uz6sd6Hi5dqG3PcrYXDc",
+	"7kwEmnSiofi58EJxjz3EXEoVlWEOMFyC3Vf64nV4quCjrJbzrGV3nypQdPm+VUz37d6joT3qbogDCIff",
+	"sdhRVDjgF73Hc0fxa/goVcpmGZ762bZVfezVmfyOle/GmSN6Zqs8SEOm/X3K7e+cKMJwBxz6fYJtfiv+",
+	"fpJLblbihzMBWiWiq1l1MGc9osfZDieSU2r+ic3+WXGOzv/m2GbBNfU10O0Vt/+Eb3/eLEpEAEY4ekby",
+	"2ng3fG5snxTZa31HkS79DFsK+7q1Dl92+OjruAQU6tZ/aOXP9omntBbfS00P+eocysrK9aBA5XlM7yt7",
+	"VT45HWJQqbNLhT2P1UPxK7UvcPzH8ALUHZNlH0vn+XW235YDi2N8Sn+9UQwTPacQCs5uF3bdRxxH/Qfr",
+	"dmSrU2ZlGEWs6REqr+ArC/KCyonXM3GK6a1EP+GG9Cq8jua+DHUbCNGg7AxmA4pRLnhwZgLxihPUmQLb",
+	"tPK2kuwB9PSke0XtT0pWcQdGpPVJTskYcyXjKOHYAbIJU4xtX6LcI5z0nq28m7JJbwEJeSTwbP75E1oN",
+	"zGC8La2q0PmleSM5LZ4xobFVeBLv5OgIw/MrnPLk78d/P86u3l79/wEAKDCaKZu2AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
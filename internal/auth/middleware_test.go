@@ -0,0 +1,22 @@
+package auth
+
+import "testing"
+
+func TestRedactURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{"/sessions/s/events", "/sessions/s/events"},
+		{"/sessions/s/events?lastEventId=3", "/sessions/s/events?lastEventId=3"},
+		{"/sessions/s/chat?access_token=abc.def", "/sessions/s/chat?access_token=REDACTED"},
+		{"/sessions/s/chat?access_token=a&access_token=b", "/sessions/s/chat?access_token=REDACTED"},
+		// 解釈できないクエリは丸ごと落とす
+		{"/sessions/s/chat?access_token=abc;%zz", "/sessions/s/chat"},
+	}
+	for _, tt := range tests {
+		if got := RedactURI(tt.uri); got != tt.want {
+			t.Errorf("RedactURI(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
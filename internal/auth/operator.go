@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"crypto/subtle"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+const OPERATOR_KEY_HEADER = "X-Operator-Key"
+
+// Operator はシナリオライブラリへの登録など、セッションに属さない管理操作の鍵を確かめる
+// セッションのトークンは誰でも発行できるので、管理操作には使えない
+type Operator struct {
+	key []byte
+}
+
+// NewOperator は key を管理用の鍵にする。空なら管理操作は誰にも許さない
+func NewOperator(key string) *Operator {
+	return &Operator{key: []byte(key)}
+}
+
+// Require は X-Operator-Key ヘッダーが管理用の鍵と一致することを要求する
+func (o *Operator) Require(c echo.Context) error {
+	if o == nil || len(o.key) == 0 {
+		return domain.ErrOperatorKeyDisabled
+	}
+	got := c.Request().Header.Get(OPERATOR_KEY_HEADER)
+	if got == "" {
+		return domain.ErrMissingOperatorKey
+	}
+	if subtle.ConstantTimeCompare([]byte(got), o.key) != 1 {
+		return domain.ErrInvalidOperatorKey
+	}
+	return nil
+}
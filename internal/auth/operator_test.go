@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+func TestOperatorRequire(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	sessionToken, err := signer.Issue("s", "h", RoleHost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		operator *Operator
+		header   string
+		value    string
+		want     error
+	}{
+		{"matching key", NewOperator("op-key"), OPERATOR_KEY_HEADER, "op-key", nil},
+		{"no key configured", NewOperator(""), OPERATOR_KEY_HEADER, "op-key", domain.ErrOperatorKeyDisabled},
+		{"missing header", NewOperator("op-key"), "", "", domain.ErrMissingOperatorKey},
+		{"wrong key", NewOperator("op-key"), OPERATOR_KEY_HEADER, "op-kez", domain.ErrInvalidOperatorKey},
+		// 誰でも発行できるセッションのトークンでは通らない
+		{"session token", NewOperator("op-key"), echo.HeaderAuthorization, "Bearer " + sessionToken, domain.ErrMissingOperatorKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/scenarios", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			if err := tt.operator.Require(c); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const TOKEN_TTL = 24 * time.Hour
+
+type Role string
+
+const (
+	RolePlayer Role = "player"
+	RoleHost   Role = "host"
+)
+
+var (
+	ErrMalformedToken = errors.New("malformed token")
+	ErrInvalidToken   = errors.New("invalid token signature")
+	ErrExpiredToken   = errors.New("token expired")
+)
+
+// Claims はトークンに埋め込むセッションと利用者の情報
+type Claims struct {
+	SessionID string `json:"sid"`
+	// プレイヤーIDまたはホストID
+	Subject   string `json:"sub"`
+	Role      Role   `json:"role"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer はサーバーの秘密鍵でHMAC署名したベアラートークンを発行・検証する
+type Signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret, now: time.Now}
+}
+
+// Issue はセッションとプレイヤーに紐づいたトークンを発行する
+func (s *Signer) Issue(sessionID string, subject string, role Role) (string, error) {
+	now := s.now()
+	payload, err := json.Marshal(Claims{
+		SessionID: sessionID,
+		Subject:   subject,
+		Role:      role,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(TOKEN_TTL).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), nil
+}
+
+func (s *Signer) Verify(token string) (*Claims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrMalformedToken
+	}
+
+	got, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	if !hmac.Equal(got, s.sign(encoded)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+	if s.now().Unix() >= claims.ExpiresAt {
+		return nil, ErrExpiredToken
+	}
+
+	return &claims, nil
+}
+
+func (s *Signer) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
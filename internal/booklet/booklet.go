@@ -0,0 +1,178 @@
+package booklet
+
+import (
+	"fmt"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// Booklet はロールまたはGMに配る冊子。ページ単位で印刷する
+type Booklet struct {
+	Title string
+	// 配布先のロールID。GMの冊子では空
+	RoleID string
+	Pages  []Page
+}
+
+type Page struct {
+	Title    string
+	Sections []Section
+}
+
+type Section struct {
+	Heading    string
+	Paragraphs []string
+}
+
+var phaseLabels = map[domain.Phase]string{
+	domain.PhaseIntro:          "イントロ",
+	domain.PhaseInvestigation1: "調査フェーズ1",
+	domain.PhaseInvestigation2: "調査フェーズ2",
+	domain.PhaseDiscussion:     "議論フェーズ",
+	domain.PhaseVoting:         "投票フェーズ",
+	domain.PhaseEnding:         "エンディング",
+}
+
+func PhaseLabel(phase domain.Phase) string {
+	if label, ok := phaseLabels[phase]; ok {
+		return label
+	}
+	return string(phase)
+}
+
+// ForRole はロールに配る冊子を作る。ほかのロールの秘密や個別情報は含めない
+func ForRole(scenario *domain.Scenario, roleID string) (*Booklet, bool) {
+	role, ok := scenario.Character(roleID)
+	if !ok {
+		return nil, false
+	}
+
+	b := &Booklet{
+		Title:  fmt.Sprintf("%s — %s", scenario.Setting.Title, role.Name),
+		RoleID: role.ID,
+	}
+	b.Pages = append(b.Pages, overviewPage(scenario), Page{
+		Title: "あなたの役: " + role.Name,
+		Sections: []Section{
+			{Heading: "プロフィール", Paragraphs: []string{role.PublicProfile}},
+			{Heading: "秘密", Paragraphs: []string{role.Secret}},
+			{Heading: "個人目標", Paragraphs: []string{role.PersonalGoal}},
+		},
+	})
+
+	others := Page{Title: "登場人物"}
+	for _, c := range scenario.Characters {
+		if c.ID != role.ID {
+			others.Sections = append(others.Sections, Section{Heading: c.Name, Paragraphs: []string{c.PublicProfile}})
+		}
+	}
+	b.Pages = append(b.Pages, others)
+
+	// フェーズごとのページは、そのフェーズで受け取る情報がある場合だけ作る
+	for _, phase := range domain.PhaseOrder {
+		page := Page{Title: PhaseLabel(phase)}
+		if investigation, ok := scenario.Phases.Investigation(phase); ok {
+			if info, ok := investigation.PrivateInfo[role.ID]; ok {
+				page.Sections = append(page.Sections, Section{Heading: "あなただけが知っている情報", Paragraphs: []string{info}})
+			}
+		}
+		for _, k := range role.Knowledge {
+			if k.Phase == phase {
+				page.Sections = append(page.Sections, Section{Heading: characterName(scenario, k.AboutRoleID) + "について", Paragraphs: []string{k.Text}})
+			}
+		}
+		if len(page.Sections) > 0 {
+			b.Pages = append(b.Pages, page)
+		}
+	}
+	return b, true
+}
+
+// ForGM はGMの台本を作る。読み上げ文をフェーズ順に並べ、全ロールの情報と真相を載せる
+func ForGM(scenario *domain.Scenario) *Booklet {
+	b := &Booklet{Title: fmt.Sprintf("%s — GMブック", scenario.Setting.Title)}
+
+	overview := overviewPage(scenario)
+	overview.Sections = append([]Section{{
+		Heading: "遊び方",
+		Paragraphs: []string{fmt.Sprintf("%d人用 / 想定プレイ時間 %d分 / 難易度 %s",
+			scenario.Meta.PlayerCount, scenario.Meta.EstimatedTimeMinutes, scenario.Meta.Difficulty)},
+	}}, overview.Sections...)
+	b.Pages = append(b.Pages, overview)
+
+	characters := Page{Title: "登場人物"}
+	for _, c := range scenario.Characters {
+		characters.Sections = append(characters.Sections, Section{
+			Heading: fmt.Sprintf("%s (%s)", c.Name, c.ID),
+			Paragraphs: []string{
+				c.PublicProfile,
+				"秘密: " + c.Secret,
+				"個人目標: " + c.PersonalGoal,
+			},
+		})
+	}
+	b.Pages = append(b.Pages, characters)
+
+	for _, phase := range domain.PhaseOrder {
+		page := Page{
+			Title:    PhaseLabel(phase),
+			Sections: []Section{{Heading: "読み上げ", Paragraphs: []string{scenario.Phases.GmText(phase)}}},
+		}
+		if investigation, ok := scenario.Phases.Investigation(phase); ok {
+			page.Sections = append(page.Sections, Section{Heading: "公開情報", Paragraphs: []string{investigation.PublicInfo}})
+
+			private := Section{Heading: "個別情報"}
+			for _, c := range scenario.Characters {
+				if info, ok := investigation.PrivateInfo[c.ID]; ok {
+					private.Paragraphs = append(private.Paragraphs, c.Name+": "+info)
+				}
+			}
+			page.Sections = append(page.Sections, private)
+		}
+
+		clues := Section{Heading: "手がかり"}
+		for _, c := range scenario.Clues {
+			if c.Phase == phase {
+				target := c.Target
+				if c.TargetType == domain.ClueTargetCharacter {
+					target = characterName(scenario, c.Target)
+				}
+				clues.Paragraphs = append(clues.Paragraphs, fmt.Sprintf("[%s] %s — %s: %s", c.ID, target, c.Title, c.Text))
+			}
+		}
+		if len(clues.Paragraphs) > 0 {
+			page.Sections = append(page.Sections, clues)
+		}
+		b.Pages = append(b.Pages, page)
+	}
+
+	truth := scenario.Truth
+	b.Pages = append(b.Pages, Page{
+		Title: "真相",
+		Sections: []Section{
+			{Heading: "犯人", Paragraphs: []string{characterName(scenario, truth.CulpritID)}},
+			{Heading: "動機", Paragraphs: []string{truth.Motive}},
+			{Heading: "手口", Paragraphs: []string{truth.Method}},
+			{Heading: "時系列", Paragraphs: []string{truth.Timeline}},
+			{Heading: "ミスリード", Paragraphs: truth.RedHerrings},
+		},
+	})
+	return b
+}
+
+func overviewPage(scenario *domain.Scenario) Page {
+	return Page{
+		Title: "事件の概要",
+		Sections: []Section{
+			{Heading: "舞台", Paragraphs: []string{scenario.Setting.WorldDescription}},
+			{Heading: "事件", Paragraphs: []string{scenario.Setting.IncidentDescription}},
+		},
+	}
+}
+
+func characterName(scenario *domain.Scenario, roleID string) string {
+	if c, ok := scenario.Character(roleID); ok {
+		return c.Name
+	}
+	return roleID
+}
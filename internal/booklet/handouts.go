@@ -0,0 +1,53 @@
+package booklet
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// WriteHandouts はGMの台本とロールごとの冊子を、HTMLとテキストの両方でZIPに書き出す
+// ファイル名は gm.html, gm.txt, p1.html, p1.txt, ...
+func WriteHandouts(w io.Writer, scenario *domain.Scenario) error {
+	booklets := []*Booklet{ForGM(scenario)}
+	for _, c := range scenario.Characters {
+		b, _ := ForRole(scenario, c.ID)
+		booklets = append(booklets, b)
+	}
+
+	zw := zip.NewWriter(w)
+	for _, b := range booklets {
+		name := b.RoleID
+		if name == "" {
+			name = "gm"
+		}
+
+		html, err := HTML(b)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", name, err)
+		}
+		if err := writeFile(zw, name+".html", html); err != nil {
+			return err
+		}
+		if err := writeFile(zw, name+".txt", Text(b)); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to close handouts: %w", err)
+	}
+	return nil
+}
+
+func writeFile(zw *zip.Writer, name string, content string) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	if _, err := io.WriteString(f, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
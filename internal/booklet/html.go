@@ -0,0 +1,47 @@
+package booklet
+
+import (
+	"html/template"
+	"strings"
+)
+
+// 1ページずつ印刷されるよう、ページごとに改ページする
+var htmlTemplate = template.Must(template.New("booklet").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+@page { size: A4; margin: 20mm; }
+body { font-family: "Hiragino Mincho ProN", "Yu Mincho", serif; line-height: 1.8; color: #222; }
+header h1 { font-size: 1.6em; border-bottom: 2px solid #222; padding-bottom: 0.3em; }
+section.page { break-after: page; }
+section.page:last-of-type { break-after: auto; }
+h2 { font-size: 1.3em; margin-top: 1.5em; }
+h3 { font-size: 1.05em; margin-bottom: 0.3em; }
+p { margin: 0 0 0.8em; }
+@media screen {
+  body { max-width: 48em; margin: 2em auto; padding: 0 1em; }
+  section.page { border-bottom: 1px dashed #999; padding-bottom: 1.5em; }
+}
+</style>
+</head>
+<body>
+<header><h1>{{.Title}}</h1></header>
+{{range .Pages}}<section class="page">
+<h2>{{.Title}}</h2>
+{{range .Sections}}<h3>{{.Heading}}</h3>
+{{range .Paragraphs}}<p>{{.}}</p>
+{{end}}{{end}}</section>
+{{end}}</body>
+</html>
+`))
+
+// HTML は冊子を印刷用のCSS付きHTMLで書き出す
+func HTML(b *Booklet) (string, error) {
+	var sb strings.Builder
+	if err := htmlTemplate.Execute(&sb, b); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,22 @@
+package booklet
+
+import "strings"
+
+// Markdown は冊子を Markdown で書き出す。ページは水平線で区切る
+func Markdown(b *Booklet) string {
+	var sb strings.Builder
+	sb.WriteString("# " + b.Title + "\n")
+	for i, page := range b.Pages {
+		if i > 0 {
+			sb.WriteString("\n---\n")
+		}
+		sb.WriteString("\n## " + page.Title + "\n")
+		for _, section := range page.Sections {
+			sb.WriteString("\n### " + section.Heading + "\n")
+			for _, p := range section.Paragraphs {
+				sb.WriteString("\n" + p + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,22 @@
+package booklet
+
+import "strings"
+
+// Text は冊子をプレーンテキストで書き出す。ページは改ページ (form feed) で区切る
+func Text(b *Booklet) string {
+	var sb strings.Builder
+	sb.WriteString(b.Title + "\n" + strings.Repeat("=", 40) + "\n")
+	for i, page := range b.Pages {
+		if i > 0 {
+			sb.WriteString("\f")
+		}
+		sb.WriteString("\n■ " + page.Title + "\n")
+		for _, section := range page.Sections {
+			sb.WriteString("\n【" + section.Heading + "】\n")
+			for _, p := range section.Paragraphs {
+				sb.WriteString(p + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// 1メッセージの最大文字数
+const MAX_CHAT_MESSAGE_LENGTH = 1000
+
+type ChatKind string
+
+const (
+	ChatPublic       ChatKind = "public"
+	ChatWhisper      ChatKind = "whisper"
+	ChatAnnouncement ChatKind = "announcement"
+)
+
+// DefaultWhisperPhases はささやきを使えるフェーズの初期値。投票中は密談させない
+var DefaultWhisperPhases = []Phase{
+	PhaseLobby,
+	PhaseIntro,
+	PhaseInvestigation1,
+	PhaseInvestigation2,
+	PhaseDiscussion,
+	PhaseEnding,
+}
+
+var (
+	ErrEmptyMessage    = NewError(ErrInvalidRequest, CodeInvalidRequest, "message text must not be empty")
+	ErrMessageTooLong  = NewError(ErrInvalidRequest, CodeInvalidRequest, "message text is too long")
+	ErrUnknownChatKind = NewError(ErrInvalidRequest, CodeInvalidRequest, "unknown message kind")
+	ErrUnknownRole     = NewError(ErrInvalidRequest, CodeInvalidRequest, "no player holds this role")
+	ErrWhisperClosed   = NewError(ErrConflict, CodeWrongPhase, "whispers are disabled in this phase")
+	ErrUnknownPhase    = NewError(ErrInvalidRequest, CodeInvalidRequest, "unknown phase")
+)
+
+type ChatMessage struct {
+	ID        int64    `json:"id"`
+	SessionID string   `json:"-"`
+	Kind      ChatKind `json:"kind"`
+	// 送信者のプレイヤーIDまたはホストID
+	SenderID     string `json:"senderId"`
+	SenderRoleID string `json:"senderRoleId,omitempty"`
+	// ささやきの宛先ロール
+	ToRoleID string    `json:"toRoleId,omitempty"`
+	Text     string    `json:"text"`
+	Phase    Phase     `json:"phase"`
+	SentAt   time.Time `json:"sentAt"`
+}
+
+// VisibleTo はメッセージを viewerID の利用者が読めるか判定する
+// ささやきは送信者と、宛先ロールを持つプレイヤーにしか見えない
+func (m *ChatMessage) VisibleTo(session *Session, viewerID string) bool {
+	if m.Kind != ChatWhisper {
+		return true
+	}
+	if m.SenderID == viewerID {
+		return true
+	}
+	player, ok := session.Players[viewerID]
+	return ok && player.RoleID == m.ToRoleID
+}
+
+func (s *Session) WhisperAllowed() bool {
+	return slices.Contains(s.WhisperPhases, s.Phase)
+}
+
+// PlayerByRole はロールを割り当てられたプレイヤーを返す
+func (s *Session) PlayerByRole(roleID string) (*Player, bool) {
+	for _, p := range s.Players {
+		if roleID != "" && p.RoleID == roleID {
+			return p, true
+		}
+	}
+	return nil, false
+}
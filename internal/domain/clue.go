@@ -0,0 +1,123 @@
+package domain
+
+import "time"
+
+type ClueTargetType string
+
+const (
+	ClueTargetLocation  ClueTargetType = "location"
+	ClueTargetCharacter ClueTargetType = "character"
+)
+
+// シナリオに行動ポイントの指定がないときの1人あたりの値
+const DEFAULT_ACTION_POINTS = 3
+
+var (
+	ErrNotInvestigating = NewError(ErrConflict, CodeWrongPhase, "clues can only be drawn in an investigation phase")
+	ErrNoActionPoints   = NewError(ErrConflict, CodeActionPointsExhausted, "no action points left in this phase")
+	ErrNoCluesLeft      = NewError(ErrConflict, CodeNoCluesLeft, "no clues left at this target")
+	ErrUnknownTarget    = NewError(ErrInvalidRequest, CodeInvalidRequest, "unknown investigation target")
+	ErrClueNotFound     = NewError(ErrNotFound, CodeClueNotFound, "clue not found")
+	ErrNotClueHolder    = NewError(ErrForbidden, CodeForbidden, "only the holder can use this clue")
+)
+
+// ClueState は引かれた手がかりカードの状態
+type ClueState struct {
+	// 調査で引いたプレイヤー。行動ポイントの消費はこちらで数える
+	DrawnBy string `json:"drawnBy"`
+	// 今持っているプレイヤー
+	HolderID string    `json:"holderId"`
+	Phase    Phase     `json:"phase"`
+	Revealed bool      `json:"revealed"`
+	DrawnAt  time.Time `json:"drawnAt"`
+	// 持ち主の履歴。引いたプレイヤーから始まり、受け渡しのたびに追加される
+	History []ClueHolding `json:"history"`
+}
+
+type ClueHolding struct {
+	PlayerID string    `json:"playerId"`
+	Since    time.Time `json:"since"`
+}
+
+// ClueTarget は調査できる対象と、まだ引かれていない手がかりの数
+type ClueTarget struct {
+	Type      ClueTargetType
+	Target    string
+	Remaining int
+}
+
+func (s *Session) InInvestigation() bool {
+	_, ok := s.Scenario.Phases.Investigation(s.Phase)
+	return ok
+}
+
+// ActionPoints は調査フェーズでの1人あたりの行動ポイント
+// シナリオの値 (省略時は DEFAULT_ACTION_POINTS) を難易度で1つ増減させる
+func (s *Session) ActionPoints(phase Phase) int {
+	investigation, ok := s.Scenario.Phases.Investigation(phase)
+	if !ok {
+		return 0
+	}
+
+	points := investigation.ActionPoints
+	if points <= 0 {
+		points = DEFAULT_ACTION_POINTS
+	}
+	switch s.Scenario.Meta.Difficulty {
+	case "easy":
+		points++
+	case "hard":
+		points--
+	}
+	return max(points, 1)
+}
+
+// ActionPointsLeft は現在のフェーズでプレイヤーに残っている行動ポイント
+func (s *Session) ActionPointsLeft(playerID string) int {
+	used := 0
+	for _, state := range s.Clues {
+		if state.DrawnBy == playerID && state.Phase == s.Phase {
+			used++
+		}
+	}
+	return max(s.ActionPoints(s.Phase)-used, 0)
+}
+
+// UndrawnClues は現在のフェーズで対象からまだ引かれていない手がかり
+func (s *Session) UndrawnClues(targetType ClueTargetType, target string) []Clue {
+	var clues []Clue
+	for _, c := range s.Scenario.Clues {
+		if c.Phase == s.Phase && c.TargetType == targetType && c.Target == target && s.Clues[c.ID] == nil {
+			clues = append(clues, c)
+		}
+	}
+	return clues
+}
+
+// ClueTargets は現在のフェーズで調べられる対象をシナリオの登場順で返す
+func (s *Session) ClueTargets() []ClueTarget {
+	var targets []ClueTarget
+	index := make(map[ClueTarget]int)
+	for _, c := range s.Scenario.Clues {
+		if c.Phase != s.Phase {
+			continue
+		}
+		key := ClueTarget{Type: c.TargetType, Target: c.Target}
+		i, ok := index[key]
+		if !ok {
+			i = len(targets)
+			index[key] = i
+			targets = append(targets, key)
+		}
+		if s.Clues[c.ID] == nil {
+			targets[i].Remaining++
+		}
+	}
+	return targets
+}
+
+// ClueVisibleTo は手がかりを viewerID の利用者が読めるか判定する
+// 公開済みなら全員、そうでなければ持ち主とホストだけが読める
+func (s *Session) ClueVisibleTo(state *ClueState, viewerID string) bool {
+	return state.Revealed || state.HolderID == viewerID || s.IsHost(viewerID)
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+type OfferStatus string
+
+const (
+	OfferPending  OfferStatus = "pending"
+	OfferAccepted OfferStatus = "accepted"
+	OfferDeclined OfferStatus = "declined"
+	// 受け取られる前に渡す側が取り下げた
+	OfferWithdrawn OfferStatus = "withdrawn"
+)
+
+var (
+	ErrClueNotTransferable = NewError(ErrConflict, CodeClueNotTransferable, "this clue cannot be handed over")
+	ErrSelfOffer           = NewError(ErrInvalidRequest, CodeInvalidRequest, "cannot offer a clue to yourself")
+	ErrOfferNotFound       = NewError(ErrNotFound, CodeOfferNotFound, "offer not found")
+	ErrOfferPending        = NewError(ErrConflict, CodeOfferPending, "this clue already has a pending offer")
+	ErrOfferClosed         = NewError(ErrConflict, CodeOfferClosed, "offer has already been answered")
+)
+
+// ClueOffer は手持ちの手がかりを他のプレイヤーに渡す申し出
+type ClueOffer struct {
+	ID         string      `json:"offerId"`
+	ClueID     string      `json:"clueId"`
+	FromID     string      `json:"fromPlayerId"`
+	ToID       string      `json:"toPlayerId"`
+	Status     OfferStatus `json:"status"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	ResolvedAt *time.Time  `json:"resolvedAt,omitempty"`
+}
+
+// Involves は申し出の当事者か判定する
+func (o *ClueOffer) Involves(playerID string) bool {
+	return o.FromID == playerID || o.ToID == playerID
+}
+
+// PendingOffer は手がかりに対する未回答の申し出を返す
+func (s *Session) PendingOffer(clueID string) (*ClueOffer, bool) {
+	for _, o := range s.ClueOffers {
+		if o.ClueID == clueID && o.Status == OfferPending {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+// TransferClue は手がかりの持ち主を変え、履歴に残す
+func (s *Session) TransferClue(clueID string, toID string, at time.Time) {
+	state := s.Clues[clueID]
+	state.HolderID = toID
+	state.History = append(state.History, ClueHolding{PlayerID: toID, Since: at})
+}
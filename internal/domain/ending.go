@@ -0,0 +1,73 @@
+package domain
+
+var ErrNotEnding = NewError(ErrConflict, CodeWrongPhase, "ending is only available in the ending phase")
+
+func (s *Session) InEnding() bool {
+	return s.Phase == PhaseEnding
+}
+
+// PlayerOutcome はエンディングで明かす各プレイヤーの勝敗
+type PlayerOutcome struct {
+	Player    *Player
+	Character *Character
+	IsCulprit bool
+	// 投票で告発されたか
+	Accused bool
+	// 投票結果による陣営の勝敗
+	TeamWon bool
+	// ホストが判定した個人目標の達成。未判定なら nil
+	GoalAchieved *bool
+	// 個人目標の判定があればそれに従い、無ければ陣営の勝敗に従う
+	Won bool
+}
+
+// JudgeGoal はロールの個人目標を達成したかを記録する
+// 個人目標は自由な文章なので、達成したかはエンディングでホストが判定する
+func (s *Session) JudgeGoal(roleID string, achieved bool) error {
+	if !s.InEnding() {
+		return ErrNotEnding
+	}
+	if _, ok := s.PlayerByRole(roleID); !ok {
+		return ErrUnknownRole
+	}
+	if s.GoalResults == nil {
+		s.GoalResults = make(map[string]bool)
+	}
+	s.GoalResults[roleID] = achieved
+	return nil
+}
+
+// Outcomes は投票結果と個人目標の判定から各プレイヤーの勝敗を決める
+// 陣営としては、犯人は告発を逃れれば勝ち、それ以外は犯人を告発できれば勝ち
+func (s *Session) Outcomes() []PlayerOutcome {
+	var accusedRoleID string
+	if s.VoteResult != nil {
+		accusedRoleID = s.VoteResult.AccusedRoleID
+	}
+	culpritID := s.Scenario.Truth.CulpritID
+	culpritCaught := accusedRoleID != "" && accusedRoleID == culpritID
+
+	outcomes := make([]PlayerOutcome, 0, len(s.Players))
+	for _, playerID := range s.PlayerIDs() {
+		player := s.Players[playerID]
+		character, _ := s.Scenario.Character(player.RoleID)
+		outcome := PlayerOutcome{
+			Player:    player,
+			Character: character,
+			IsCulprit: player.RoleID == culpritID,
+			Accused:   player.RoleID != "" && player.RoleID == accusedRoleID,
+		}
+		if outcome.IsCulprit {
+			outcome.TeamWon = !culpritCaught
+		} else {
+			outcome.TeamWon = culpritCaught
+		}
+		outcome.Won = outcome.TeamWon
+		if achieved, ok := s.GoalResults[player.RoleID]; ok {
+			outcome.GoalAchieved = &achieved
+			outcome.Won = achieved
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func endingSession(accused string) *Session {
+	s := votingSession(TieBreakRevote, 1, map[string]string{})
+	s.Phase = PhaseEnding
+	s.Players = map[string]*Player{
+		"a": {ID: "a", RoleID: "p1"},
+		"b": {ID: "b", RoleID: "p2"},
+	}
+	s.VoteResult = &VoteResult{Round: 1, AccusedRoleID: accused, CulpritID: "p2"}
+	return s
+}
+
+func TestOutcomes(t *testing.T) {
+	tests := []struct {
+		name    string
+		accused string
+		goals   map[string]bool
+		// ロールID → 陣営の勝敗、最終的な勝敗
+		wantTeam map[string]bool
+		wantWon  map[string]bool
+	}{
+		{
+			name:     "culprit caught, no judgement",
+			accused:  "p2",
+			wantTeam: map[string]bool{"p1": true, "p2": false},
+			wantWon:  map[string]bool{"p1": true, "p2": false},
+		},
+		{
+			name:     "culprit escapes, no judgement",
+			accused:  "p1",
+			wantTeam: map[string]bool{"p1": false, "p2": true},
+			wantWon:  map[string]bool{"p1": false, "p2": true},
+		},
+		{
+			name:     "judged goals override the team result",
+			accused:  "p2",
+			goals:    map[string]bool{"p1": false, "p2": true},
+			wantTeam: map[string]bool{"p1": true, "p2": false},
+			wantWon:  map[string]bool{"p1": false, "p2": true},
+		},
+		{
+			name:     "unjudged roles keep the team result",
+			accused:  "",
+			goals:    map[string]bool{"p1": true},
+			wantTeam: map[string]bool{"p1": false, "p2": true},
+			wantWon:  map[string]bool{"p1": true, "p2": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := endingSession(tt.accused)
+			s.GoalResults = tt.goals
+
+			for _, o := range s.Outcomes() {
+				roleID := o.Player.RoleID
+				if o.TeamWon != tt.wantTeam[roleID] || o.Won != tt.wantWon[roleID] {
+					t.Errorf("%s: teamWon = %v, won = %v, want %v, %v", roleID, o.TeamWon, o.Won, tt.wantTeam[roleID], tt.wantWon[roleID])
+				}
+				achieved, judged := tt.goals[roleID]
+				if (o.GoalAchieved != nil) != judged || (judged && *o.GoalAchieved != achieved) {
+					t.Errorf("%s: goalAchieved = %v, want judged %v as %v", roleID, o.GoalAchieved, judged, achieved)
+				}
+				if o.IsCulprit != (roleID == "p2") || o.Accused != (roleID == tt.accused) {
+					t.Errorf("%s: isCulprit = %v, accused = %v", roleID, o.IsCulprit, o.Accused)
+				}
+			}
+		})
+	}
+}
+
+func TestJudgeGoal(t *testing.T) {
+	s := endingSession("p2")
+	if err := s.JudgeGoal("p1", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.JudgeGoal("p5", true); err != ErrUnknownRole {
+		t.Errorf("err = %v, want %v", err, ErrUnknownRole)
+	}
+
+	// エンディングから戻ると判定をやり直す
+	if err := s.Rewind("host", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.GoalResults) != 0 {
+		t.Errorf("goal results kept after rewind: %v", s.GoalResults)
+	}
+	if err := s.JudgeGoal("p1", true); err != ErrNotEnding {
+		t.Errorf("err = %v, want %v", err, ErrNotEnding)
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import "errors"
+
+// エラーの種類。HTTPステータスへの対応付けはこの単位で行う
+var (
+	ErrInvalidRequest         = errors.New("invalid request")
+	ErrUnauthorized           = errors.New("unauthorized")
+	ErrNotFound               = errors.New("not found")
+	ErrInvalidPhaseTransition = errors.New("invalid phase transition")
+	ErrConflict               = errors.New("conflict")
+	ErrSessionFull            = errors.New("session full")
+	ErrForbidden              = errors.New("forbidden")
+	// シナリオ生成などの外部サービスが使える結果を返さなかった
+	ErrUpstream = errors.New("upstream failure")
+)
+
+type ErrorCode string
+
+const (
+	CodeInvalidRequest         ErrorCode = "invalid_request"
+	CodeUnauthorized           ErrorCode = "unauthorized"
+	CodeSessionNotFound        ErrorCode = "session_not_found"
+	CodePlayerNotFound         ErrorCode = "player_not_found"
+	CodeInvalidPhaseTransition ErrorCode = "invalid_phase_transition"
+	CodeWrongPhase             ErrorCode = "wrong_phase"
+	CodeLobbyNotReady          ErrorCode = "lobby_not_ready"
+	CodeSessionFull            ErrorCode = "session_full"
+	CodeForbidden              ErrorCode = "forbidden"
+	CodeVoteTied               ErrorCode = "vote_tied"
+	CodeActionPointsExhausted  ErrorCode = "action_points_exhausted"
+	CodeNoCluesLeft            ErrorCode = "no_clues_left"
+	CodeClueNotFound           ErrorCode = "clue_not_found"
+	CodeClueNotTransferable    ErrorCode = "clue_not_transferable"
+	CodeOfferNotFound          ErrorCode = "offer_not_found"
+	CodeOfferPending           ErrorCode = "offer_pending"
+	CodeOfferClosed            ErrorCode = "offer_closed"
+	CodeTimerState             ErrorCode = "timer_state"
+	CodeVotesMissing           ErrorCode = "votes_missing"
+	CodeScenarioNotFound       ErrorCode = "scenario_not_found"
+	CodeInvalidScenario        ErrorCode = "invalid_scenario"
+	CodeGenerationFailed       ErrorCode = "scenario_generation_failed"
+)
+
+// Error はクライアントが分岐に使える Code を持つドメインエラー
+// errors.Is で Kind の種類と比較できる
+type Error struct {
+	Code    ErrorCode
+	Kind    error
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func NewError(kind error, code ErrorCode, message string) *Error {
+	return &Error{Code: code, Kind: kind, Message: message}
+}
+
+var (
+	ErrSessionNotFound = NewError(ErrNotFound, CodeSessionNotFound, "session not found")
+	ErrPlayerNotFound  = NewError(ErrNotFound, CodePlayerNotFound, "player not found")
+	ErrNoNextPhase     = NewError(ErrInvalidPhaseTransition, CodeInvalidPhaseTransition, "session is already in the last phase")
+
+	ErrMissingCredentials = NewError(ErrUnauthorized, CodeUnauthorized, "bearer token is required")
+	ErrInvalidCredentials = NewError(ErrUnauthorized, CodeUnauthorized, "bearer token is invalid or expired")
+	ErrNotAllowed         = NewError(ErrForbidden, CodeForbidden, "not allowed to perform this action")
+	ErrNotHost            = NewError(ErrForbidden, CodeForbidden, "only the host can perform this action")
+
+	ErrMissingOperatorKey  = NewError(ErrUnauthorized, CodeUnauthorized, "X-Operator-Key header is required")
+	ErrInvalidOperatorKey  = NewError(ErrUnauthorized, CodeUnauthorized, "operator key is invalid")
+	ErrOperatorKeyDisabled = NewError(ErrForbidden, CodeForbidden, "operator endpoints are disabled on this server")
+)
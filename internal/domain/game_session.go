@@ -1,10 +1,94 @@
 package domain
 
+import (
+	"maps"
+	"slices"
+)
+
 type Session struct {
 	ID       string
+	JoinCode string
+	// 進行権限を持つ利用者。作成時はGM専用のIDで、プレイヤーに引き継げる
+	HostID   string
 	Phase    Phase
 	Scenario *Scenario
-	Players  map[string]*Player
+	// シナリオライブラリ上のID
+	ScenarioID     string
+	RoleAssignment RoleAssignment
+	Players        map[string]*Player
+	// ホスト引き継ぎの投票。投票したプレイヤーID → 推薦先プレイヤーID
+	HostVotes map[string]string
+	// ささやきを使えるフェーズ
+	WhisperPhases []Phase
+	// 投票フェーズで同数になったときの決め方
+	TieBreak TieBreak
+	// 投票中の票。投票したプレイヤーID → 告発したロールID
+	Votes map[string]string
+	// 再投票のたびに増える投票回数。最初は1
+	VoteRound int
+	// 同数のときにホストが選んだロール
+	TieDecision string
+	// 投票フェーズを終えると設定される
+	VoteResult *VoteResult
+	// 引かれた手がかり。手がかりID → 状態
+	Clues map[string]*ClueState
+	// 手がかりの受け渡しの申し出。申し出ID → 申し出
+	ClueOffers map[string]*ClueOffer
+	// フェーズの持ち時間と警告、自動進行の設定
+	TimerSettings TimerSettings
+	// 現在のフェーズのタイマー。持ち時間のないフェーズでは nil
+	Timer *PhaseTimer
+	// フェーズ遷移の履歴。古い順
+	PhaseHistory []Transition
+	// エンディングでホストが判定した個人目標の達成。ロールID → 達成したか
+	GoalResults map[string]bool
 }
 
+func (s *Session) IsHost(id string) bool {
+	return id != "" && s.HostID == id
+}
 
+// Clone はセッションの複製を返す。複製を書き換えても元のセッションには影響しない
+// シナリオは作成後に書き換えないので共有する
+func (s *Session) Clone() *Session {
+	c := *s
+	c.Players = make(map[string]*Player, len(s.Players))
+	for id, p := range s.Players {
+		player := *p
+		c.Players[id] = &player
+	}
+	c.HostVotes = maps.Clone(s.HostVotes)
+	c.WhisperPhases = slices.Clone(s.WhisperPhases)
+	c.Votes = maps.Clone(s.Votes)
+	if s.VoteResult != nil {
+		result := *s.VoteResult
+		result.Votes = maps.Clone(s.VoteResult.Votes)
+		result.Tally = maps.Clone(s.VoteResult.Tally)
+		c.VoteResult = &result
+	}
+	c.Clues = make(map[string]*ClueState, len(s.Clues))
+	for id, st := range s.Clues {
+		state := *st
+		state.History = slices.Clone(st.History)
+		c.Clues[id] = &state
+	}
+	c.ClueOffers = make(map[string]*ClueOffer, len(s.ClueOffers))
+	for id, o := range s.ClueOffers {
+		offer := *o
+		if o.ResolvedAt != nil {
+			at := *o.ResolvedAt
+			offer.ResolvedAt = &at
+		}
+		c.ClueOffers[id] = &offer
+	}
+	c.TimerSettings.PhaseDurations = maps.Clone(s.TimerSettings.PhaseDurations)
+	c.TimerSettings.Warnings = slices.Clone(s.TimerSettings.Warnings)
+	if s.Timer != nil {
+		timer := *s.Timer
+		timer.Warned = slices.Clone(s.Timer.Warned)
+		c.Timer = &timer
+	}
+	c.PhaseHistory = slices.Clone(s.PhaseHistory)
+	c.GoalResults = maps.Clone(s.GoalResults)
+	return &c
+}
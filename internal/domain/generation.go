@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// Tone はシナリオの作風
+type Tone string
+
+const (
+	ToneComedic Tone = "comedic"
+	ToneSerious Tone = "serious"
+)
+
+// ContentRestriction はシナリオに含めない表現
+type ContentRestriction string
+
+const (
+	RestrictNoGore          ContentRestriction = "no_gore"
+	RestrictNoSexualContent ContentRestriction = "no_sexual_content"
+	RestrictNoSelfHarm      ContentRestriction = "no_self_harm"
+)
+
+var contentRestrictions = []ContentRestriction{RestrictNoGore, RestrictNoSexualContent, RestrictNoSelfHarm}
+
+// テーマや時代の自由記述の上限
+const MAX_GENERATION_TEXT_RUNES = 100
+
+// 遊べる人数。scenario.schema.json の meta.playerCount と合わせる
+const (
+	MIN_PLAYER_COUNT = 4
+	MAX_PLAYER_COUNT = 5
+)
+
+// difficulties は scenario.schema.json の meta.difficulty と合わせる
+var difficulties = []string{"easy", "medium", "hard"}
+
+var (
+	ErrInvalidTone               = NewError(ErrInvalidRequest, CodeInvalidRequest, "tone must be comedic or serious")
+	ErrInvalidContentRestriction = NewError(ErrInvalidRequest, CodeInvalidRequest, "unknown content restriction")
+	ErrInvalidLanguage           = NewError(ErrInvalidRequest, CodeInvalidRequest, "language must be a BCP 47 tag such as ja or en")
+	ErrGenerationTextTooLong     = NewError(ErrInvalidRequest, CodeInvalidRequest, "theme and era must be at most 100 characters")
+	ErrInvalidPlayerCount        = NewError(ErrInvalidRequest, CodeInvalidRequest, "playerCount must be 4 or 5")
+	ErrInvalidDifficulty         = NewError(ErrInvalidRequest, CodeInvalidRequest, "difficulty must be easy, medium or hard")
+	ErrGenerationFailed          = NewError(ErrUpstream, CodeGenerationFailed, "the scenario generator did not return a usable scenario")
+)
+
+// ValidateScenarioSize は依頼された人数と難易度がシナリオとして作れるものかを確かめる
+func ValidateScenarioSize(playerCount int, difficulty string) error {
+	if playerCount < MIN_PLAYER_COUNT || playerCount > MAX_PLAYER_COUNT {
+		return ErrInvalidPlayerCount
+	}
+	if !slices.Contains(difficulties, difficulty) {
+		return ErrInvalidDifficulty
+	}
+	return nil
+}
+
+// GenerationParams はシナリオ生成時に指定する作風。生成結果の meta にも同じ値が入る
+type GenerationParams struct {
+	// 舞台のテーマ。洋館、学校、宇宙船など
+	Theme string `json:"theme,omitempty"`
+	// 時代設定
+	Era                 string               `json:"era,omitempty"`
+	Tone                Tone                 `json:"tone,omitempty"`
+	ContentRestrictions []ContentRestriction `json:"contentRestrictions,omitempty"`
+	// 本文の言語。BCP 47 の言語タグ
+	Language string `json:"language,omitempty"`
+}
+
+// Normalize は値を検証し、言語タグを正規化したものを返す
+func (p GenerationParams) Normalize() (GenerationParams, error) {
+	if utf8.RuneCountInString(p.Theme) > MAX_GENERATION_TEXT_RUNES || utf8.RuneCountInString(p.Era) > MAX_GENERATION_TEXT_RUNES {
+		return p, ErrGenerationTextTooLong
+	}
+	if p.Tone != "" && p.Tone != ToneComedic && p.Tone != ToneSerious {
+		return p, ErrInvalidTone
+	}
+	for _, r := range p.ContentRestrictions {
+		if !slices.Contains(contentRestrictions, r) {
+			return p, ErrInvalidContentRestriction
+		}
+	}
+	if p.Language != "" {
+		tag, err := language.Parse(p.Language)
+		if err != nil {
+			return p, ErrInvalidLanguage
+		}
+		p.Language = tag.String()
+	}
+	return p, nil
+}
+
+// Unmet は依頼した指定のうち、生成結果の meta (got) が満たしていない項目名を返す
+func (p GenerationParams) Unmet(got GenerationParams) []string {
+	var missing []string
+	if p.Theme != "" && got.Theme != p.Theme {
+		missing = append(missing, "theme")
+	}
+	if p.Era != "" && got.Era != p.Era {
+		missing = append(missing, "era")
+	}
+	if p.Tone != "" && got.Tone != p.Tone {
+		missing = append(missing, "tone")
+	}
+	for _, r := range p.ContentRestrictions {
+		if !slices.Contains(got.ContentRestrictions, r) {
+			missing = append(missing, "contentRestrictions")
+			break
+		}
+	}
+	if p.Language != "" {
+		if tag, err := language.Parse(got.Language); err != nil || tag.String() != p.Language {
+			missing = append(missing, "language")
+		}
+	}
+	return missing
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+const (
+	JOIN_CODE_LENGTH = 6
+	// 0/O, 1/I/L など見間違えやすい文字を除いている
+	JOIN_CODE_ALPHABET = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
+
+// NewJoinCode はランダムな参加コードを返す。既存セッションとの衝突は呼び出し側で確かめる
+func NewJoinCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(JOIN_CODE_ALPHABET)))
+
+	var b strings.Builder
+	for range JOIN_CODE_LENGTH {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(JOIN_CODE_ALPHABET[n.Int64()])
+	}
+	return b.String(), nil
+}
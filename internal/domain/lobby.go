@@ -0,0 +1,37 @@
+package domain
+
+var (
+	ErrAlreadyStarted  = NewError(ErrConflict, CodeWrongPhase, "session has already started")
+	ErrNotStarted      = NewError(ErrConflict, CodeWrongPhase, "session is still in the lobby")
+	ErrPlayerCount     = NewError(ErrConflict, CodeLobbyNotReady, "number of players does not match the scenario")
+	ErrPlayersNotReady = NewError(ErrConflict, CodeLobbyNotReady, "not every player is ready")
+	ErrRolesNotDealt   = NewError(ErrConflict, CodeLobbyNotReady, "roles have not been dealt to every player")
+)
+
+func (s *Session) InLobby() bool {
+	return s.Phase == PhaseLobby
+}
+
+// CanStart はロビーからゲームを開始できるかを確認する
+// ロールは開始時に配るもの (shuffle) 以外、全員に割り当て済みである必要がある
+func (s *Session) CanStart() error {
+	if !s.InLobby() {
+		return ErrAlreadyStarted
+	}
+	if len(s.Players) != s.Scenario.Meta.PlayerCount {
+		return ErrPlayerCount
+	}
+	for _, p := range s.Players {
+		if !p.Ready {
+			return ErrPlayersNotReady
+		}
+	}
+	if s.RoleAssignment != RoleAssignmentShuffle {
+		for _, p := range s.Players {
+			if p.RoleID == "" {
+				return ErrRolesNotDealt
+			}
+		}
+	}
+	return nil
+}
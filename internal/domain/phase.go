@@ -1,8 +1,13 @@
 package domain
 
+import "slices"
+
 type Phase string
 
 const (
+	// 参加者が集まるまでの待機フェーズ。PhaseOrder には含まず、開始操作で PhaseIntro に進む
+	// 遷移の規則は phase_machine.go にまとめている
+	PhaseLobby          Phase = "lobby"
 	PhaseIntro          Phase = "intro"
 	PhaseInvestigation1 Phase = "investigation1"
 	PhaseInvestigation2 Phase = "investigation2"
@@ -19,3 +24,13 @@ var PhaseOrder = []Phase{
 	PhaseVoting,
 	PhaseEnding,
 }
+
+func (p Phase) Valid() bool {
+	return p == PhaseLobby || slices.Contains(PhaseOrder, p)
+}
+
+// Reached は current が p と同じか、それより後のフェーズか判定する
+func (p Phase) Reached(current Phase) bool {
+	i := slices.Index(PhaseOrder, p)
+	return i >= 0 && i <= slices.Index(PhaseOrder, current)
+}
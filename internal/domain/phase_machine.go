@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type TransitionKind string
+
+const (
+	TransitionStart   TransitionKind = "start"
+	TransitionAdvance TransitionKind = "advance"
+	TransitionRewind  TransitionKind = "rewind"
+	// タイマーの時間切れによる自動進行
+	TransitionTimer TransitionKind = "timer"
+)
+
+var (
+	ErrNoPreviousPhase = NewError(ErrInvalidPhaseTransition, CodeInvalidPhaseTransition, "session cannot go back from this phase")
+	ErrVotesMissing    = NewError(ErrConflict, CodeVotesMissing, "not every player has voted; force to close the vote anyway")
+)
+
+// Transition はフェーズ遷移の履歴1件
+type Transition struct {
+	From Phase          `json:"from"`
+	To   Phase          `json:"to"`
+	Kind TransitionKind `json:"kind"`
+	// 操作した利用者。タイマーによる遷移では空
+	ActorID string    `json:"actorId,omitempty"`
+	Forced  bool      `json:"forced,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// phaseGuards はフェーズを抜ける前に満たすべき条件。force で無視できる
+var phaseGuards = map[Phase]func(*Session) error{
+	PhaseVoting: (*Session).allVoted,
+}
+
+// Next は進行順で次のフェーズを返す。ロビーの次はイントロ
+func (p Phase) Next() (Phase, bool) {
+	if p == PhaseLobby {
+		return PhaseIntro, true
+	}
+	i := slices.Index(PhaseOrder, p)
+	if i < 0 || i+1 >= len(PhaseOrder) {
+		return "", false
+	}
+	return PhaseOrder[i+1], true
+}
+
+// Previous は巻き戻し先のフェーズを返す。ロールを配り終えているのでロビーには戻れない
+func (p Phase) Previous() (Phase, bool) {
+	i := slices.Index(PhaseOrder, p)
+	if i <= 0 {
+		return "", false
+	}
+	return PhaseOrder[i-1], true
+}
+
+// CanLeave は現在のフェーズを抜けられるか確認する
+func (s *Session) CanLeave(force bool) error {
+	guard, ok := phaseGuards[s.Phase]
+	if !ok || force {
+		return nil
+	}
+	return guard(s)
+}
+
+func (s *Session) allVoted() error {
+	for id := range s.Players {
+		if _, ok := s.Votes[id]; !ok {
+			return ErrVotesMissing
+		}
+	}
+	return nil
+}
+
+// Transit はフェーズを切り替えて履歴に残す。遷移できるかは呼び出し側で確認すること
+func (s *Session) Transit(to Phase, kind TransitionKind, actorID string, forced bool, now time.Time) {
+	s.PhaseHistory = append(s.PhaseHistory, Transition{
+		From:    s.Phase,
+		To:      to,
+		Kind:    kind,
+		ActorID: actorID,
+		Forced:  forced,
+		At:      now,
+	})
+	s.EnterPhase(to, now)
+}
+
+// Rewind は1つ前のフェーズに戻す。エンディングから戻る場合は締め切った投票を再開し、個人目標の判定を消す
+// 投票フェーズから戻る場合は、次に入った時に最初から投票し直すよう票と裁定を消す
+func (s *Session) Rewind(actorID string, now time.Time) error {
+	previous, ok := s.Phase.Previous()
+	if !ok {
+		return ErrNoPreviousPhase
+	}
+
+	if s.InVoting() {
+		clear(s.Votes)
+		s.TieDecision = ""
+		s.VoteRound = 1
+	}
+	if s.InEnding() && s.VoteResult != nil {
+		s.Votes = s.VoteResult.Votes
+		if s.Votes == nil {
+			s.Votes = make(map[string]string)
+		}
+		s.VoteRound = s.VoteResult.Round
+		s.VoteResult = nil
+	}
+	if s.InEnding() {
+		clear(s.GoalResults)
+	}
+	s.Transit(previous, TransitionRewind, actorID, false, now)
+	return nil
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRewindOutOfVotingStartsOver(t *testing.T) {
+	s := votingSession(TieBreakGM, 2, map[string]string{"a": "p1", "b": "p1", "c": "p2", "d": "p2"})
+	s.TieDecision = "p2"
+
+	if err := s.Rewind("host", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if s.Phase != PhaseDiscussion {
+		t.Errorf("phase = %s, want %s", s.Phase, PhaseDiscussion)
+	}
+	if len(s.Votes) != 0 || s.TieDecision != "" || s.VoteRound != 1 {
+		t.Errorf("votes = %v, tie decision = %q, round = %d; want a fresh vote", s.Votes, s.TieDecision, s.VoteRound)
+	}
+}
@@ -1,6 +1,11 @@
 package domain
 
+import "time"
+
 type Player struct {
-	ID     string
-	RoleID string // p1–p5
+	ID       string
+	Name     string
+	RoleID   string // p1–p5
+	Ready    bool
+	JoinedAt time.Time
 }
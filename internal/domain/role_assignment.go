@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// RoleAssignment はプレイヤーへのロールの割り当て方
+type RoleAssignment string
+
+const (
+	// 参加順にシナリオの先頭から割り当てる
+	RoleAssignmentJoinOrder RoleAssignment = "join_order"
+	// ゲーム開始時に参加者へ無作為に配る
+	RoleAssignmentShuffle RoleAssignment = "shuffle"
+	// ホストが指定する
+	RoleAssignmentHost RoleAssignment = "host"
+)
+
+var (
+	ErrNoOpenRole            = NewError(ErrSessionFull, CodeSessionFull, "all roles are already taken")
+	ErrRolesAssignedOnJoin   = NewError(ErrInvalidRequest, CodeInvalidRequest, "roles are assigned when players join in this session")
+	ErrInvalidRoleAssignment = NewError(ErrInvalidRequest, CodeInvalidRequest, "every player must get a distinct role of the scenario")
+	ErrRolesLocked           = NewError(ErrConflict, CodeWrongPhase, "roles can only be dealt in the lobby")
+)
+
+func (r RoleAssignment) Valid() bool {
+	switch r {
+	case RoleAssignmentJoinOrder, RoleAssignmentShuffle, RoleAssignmentHost:
+		return true
+	}
+	return false
+}
+
+// IsFull はシナリオのロール数だけプレイヤーが参加済みかどうか
+func (s *Session) IsFull() bool {
+	return len(s.Players) >= len(s.Scenario.Characters)
+}
+
+// OpenRoles はまだ誰にも割り当てられていないロールIDをシナリオの順に返す
+func (s *Session) OpenRoles() []string {
+	taken := make(map[string]bool, len(s.Players))
+	for _, p := range s.Players {
+		taken[p.RoleID] = true
+	}
+
+	var open []string
+	for _, c := range s.Scenario.Characters {
+		if !taken[c.ID] {
+			open = append(open, c.ID)
+		}
+	}
+	return open
+}
+
+// AssignRoles は playerID → roleID の割り当てを検証して反映する
+// 参加中の全員に、重複のないシナリオ上のロールを割り当てる必要がある
+func (s *Session) AssignRoles(assignments map[string]string) error {
+	if len(assignments) != len(s.Players) {
+		return ErrInvalidRoleAssignment
+	}
+
+	used := make(map[string]bool, len(assignments))
+	for playerID, roleID := range assignments {
+		if _, ok := s.Players[playerID]; !ok {
+			return ErrPlayerNotFound
+		}
+		if _, ok := s.Scenario.Character(roleID); !ok || used[roleID] {
+			return ErrInvalidRoleAssignment
+		}
+		used[roleID] = true
+	}
+
+	for playerID, roleID := range assignments {
+		s.Players[playerID].RoleID = roleID
+	}
+	return nil
+}
+
+// PlayerIDs はプレイヤーIDを参加順に返す
+func (s *Session) PlayerIDs() []string {
+	ids := make([]string, 0, len(s.Players))
+	for id := range s.Players {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		if c := s.Players[a].JoinedAt.Compare(s.Players[b].JoinedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	return ids
+}
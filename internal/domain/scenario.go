@@ -1,46 +1,144 @@
 package domain
 
+// Scenario は internal/schema/scenario.schema.json に対応する
 type Scenario struct {
 	Meta ScenarioMeta `json:"meta"`
-	Roles []Role `json:"roles"`
-	Phases []PhaseContent `json:"phases"`
+	Setting Setting `json:"setting"`
+	Characters []Character `json:"characters"`
+	Phases ScenarioPhases `json:"phases"`
+	Truth Truth `json:"truth"`
+	Clues []Clue `json:"clues,omitempty"`
 }
 
 type ScenarioMeta struct {
-	Title string `json:"title"`
-	DurationMinutes int `json:"durationMinutes"`
 	PlayerCount int `json:"playerCount"`
+	EstimatedTimeMinutes int `json:"estimatedTimeMinutes"`
+	Difficulty string `json:"difficulty"`
+	GenerationParams
 }
 
-type Role struct {
+type Setting struct {
+	Title string `json:"title"`
+	WorldDescription string `json:"worldDescription"`
+	IncidentDescription string `json:"incidentDescription"`
+}
+
+// Character の ID (p1–p5) がそのままロールIDになる
+type Character struct {
 	ID string `json:"id"`
 	Name string `json:"name"`
-	Description string `json:"description"`
-	Secret []Secret `json:"secret,omitempty"`
+	PublicProfile string `json:"publicProfile"`
+	Secret string `json:"secret"`
+	PersonalGoal string `json:"personalGoal"`
+	// このキャラクターだけが知っている他のキャラクターの秘密
+	Knowledge []Knowledge `json:"knowledge,omitempty"`
+}
+
+// Knowledge は Phase 以降に明かされる、他のキャラクターについての情報
+type Knowledge struct {
+	AboutRoleID string `json:"aboutRoleId"`
+	Phase Phase `json:"phase"`
+	Text string `json:"text"`
 }
 
-type Secret struct {
-	TargetRoleID string `json:"targetRoleID,omitempty"`
-	Content string `json:"content"`
+type ScenarioPhases struct {
+	Intro PhaseText `json:"intro"`
+	Investigation1 InvestigationPhase `json:"investigation1"`
+	Investigation2 InvestigationPhase `json:"investigation2"`
+	Discussion PhaseText `json:"discussion"`
+	Voting PhaseText `json:"voting"`
+	Ending PhaseText `json:"ending"`
 }
 
-type PhaseContent struct {
+type PhaseText struct {
+	GmText string `json:"gmText"`
+}
+
+type InvestigationPhase struct {
+	GmText string `json:"gmText"`
+	PublicInfo string `json:"publicInfo"`
+	// ロールIDごとの個別情報
+	PrivateInfo map[string]string `json:"privateInfo"`
+	// 1人あたりの行動ポイント。省略時は難易度から決める
+	ActionPoints int `json:"actionPoints,omitempty"`
+}
+
+// Clue は調査フェーズで場所やキャラクターを調べると引ける手がかりカード
+type Clue struct {
+	ID string `json:"id"`
 	Phase Phase `json:"phase"`
-	Public PhasePublicInfo `json:"public"`
-	Private []PhasePrivateInfo `json:"private,omitempty"`
+	TargetType ClueTargetType `json:"targetType"`
+	// 場所名、またはキャラクターID
+	Target string `json:"target"`
+	Title string `json:"title"`
+	Text string `json:"text"`
+	// true なら他のプレイヤーに渡せない
+	NonTransferable bool `json:"nonTransferable,omitempty"`
+}
+
+type Truth struct {
+	CulpritID string `json:"culpritId"`
+	Motive string `json:"motive"`
+	Method string `json:"method"`
+	Timeline string `json:"timeline"`
+	RedHerrings []string `json:"redHerrings"`
+}
+
+func (s *Scenario) Character(id string) (*Character, bool) {
+	for i := range s.Characters {
+		if s.Characters[i].ID == id {
+			return &s.Characters[i], true
+		}
+	}
+	return nil, false
+}
+
+func (s *Scenario) Clue(id string) (*Clue, bool) {
+	for i := range s.Clues {
+		if s.Clues[i].ID == id {
+			return &s.Clues[i], true
+		}
+	}
+	return nil, false
 }
 
-type PhasePublicInfo struct {
-	Description string `json:"description"`
-	Actions []Action `json:"actions,omitempty"`
+// KnowledgeAt は phase の時点で明かされている情報を返す
+func (c *Character) KnowledgeAt(phase Phase) []Knowledge {
+	var known []Knowledge
+	for _, k := range c.Knowledge {
+		if k.Phase.Reached(phase) {
+			known = append(known, k)
+		}
+	}
+	return known
 }
 
-type PhasePrivateInfo struct {
-	RoleID string `json:"roleId"`
-	Hints []string `json:"hints"`
+// GmText は指定フェーズのGM読み上げ文を返す
+func (p ScenarioPhases) GmText(phase Phase) string {
+	switch phase {
+	case PhaseIntro:
+		return p.Intro.GmText
+	case PhaseInvestigation1:
+		return p.Investigation1.GmText
+	case PhaseInvestigation2:
+		return p.Investigation2.GmText
+	case PhaseDiscussion:
+		return p.Discussion.GmText
+	case PhaseVoting:
+		return p.Voting.GmText
+	case PhaseEnding:
+		return p.Ending.GmText
+	}
+	return ""
 }
 
-type Action struct {
-	Type string `json:"type"`
-	Description string `json:"description"`
+// Investigation は調査フェーズの内容を返す。調査フェーズ以外では false
+func (p ScenarioPhases) Investigation(phase Phase) (*InvestigationPhase, bool) {
+	switch phase {
+	case PhaseInvestigation1:
+		return &p.Investigation1, true
+	case PhaseInvestigation2:
+		return &p.Investigation2, true
+	}
+	return nil, false
 }
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// ScenarioSource はライブラリのシナリオがどこから来たか
+type ScenarioSource string
+
+const (
+	ScenarioSourceGenerated ScenarioSource = "generated"
+	ScenarioSourceUploaded  ScenarioSource = "uploaded"
+)
+
+var ErrScenarioNotFound = NewError(ErrNotFound, CodeScenarioNotFound, "scenario not found")
+
+// LibraryScenario は保存して再利用できるシナリオ
+type LibraryScenario struct {
+	ID          string
+	Title       string
+	PlayerCount int
+	Difficulty  string
+	Source      ScenarioSource
+	// このシナリオで開始したセッションの数
+	PlayCount int
+	CreatedAt time.Time
+	Scenario  *Scenario
+}
+
+func NewLibraryScenario(id string, scenario *Scenario, source ScenarioSource, now time.Time) *LibraryScenario {
+	return &LibraryScenario{
+		ID:          id,
+		Title:       scenario.Setting.Title,
+		PlayerCount: scenario.Meta.PlayerCount,
+		Difficulty:  scenario.Meta.Difficulty,
+		Source:      source,
+		CreatedAt:   now,
+		Scenario:    scenario,
+	}
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// 残り時間の警告を出す既定のタイミング
+var DefaultTimerWarnings = []time.Duration{5 * time.Minute, time.Minute}
+
+// シナリオの想定プレイ時間をフェーズに割り振る重み。ロビーとエンディングは時間を計らない
+var phaseTimeWeights = map[Phase]int{
+	PhaseIntro:          1,
+	PhaseInvestigation1: 3,
+	PhaseInvestigation2: 3,
+	PhaseDiscussion:     3,
+	PhaseVoting:         1,
+}
+
+var (
+	ErrTimerNotRunning = NewError(ErrConflict, CodeTimerState, "no timer is running in this phase")
+	ErrTimerNotPaused  = NewError(ErrConflict, CodeTimerState, "timer is not paused")
+	ErrTimerSettings   = NewError(ErrInvalidRequest, CodeInvalidRequest, "invalid timer settings")
+)
+
+type TimerState string
+
+const (
+	TimerNone    TimerState = "none"
+	TimerRunning TimerState = "running"
+	TimerPaused  TimerState = "paused"
+	TimerExpired TimerState = "expired"
+)
+
+// TimerSettings はセッション作成時に決めるフェーズタイマーの設定
+type TimerSettings struct {
+	// フェーズごとの持ち時間。0 や未設定のフェーズは時間を計らない
+	PhaseDurations map[Phase]time.Duration `json:"phaseDurations"`
+	// 残り時間がこの値を切ったときに警告する
+	Warnings []time.Duration `json:"warnings"`
+	// 時間切れで自動的に次のフェーズへ進める
+	AutoAdvance bool `json:"autoAdvance"`
+}
+
+// DefaultPhaseDurations はシナリオの想定プレイ時間をフェーズに割り振る
+func DefaultPhaseDurations(scenario *Scenario) map[Phase]time.Duration {
+	total := 0
+	for _, w := range phaseTimeWeights {
+		total += w
+	}
+
+	budget := time.Duration(scenario.Meta.EstimatedTimeMinutes) * time.Minute
+	durations := make(map[Phase]time.Duration, len(phaseTimeWeights))
+	for phase, w := range phaseTimeWeights {
+		durations[phase] = (budget * time.Duration(w) / time.Duration(total)).Round(time.Minute)
+	}
+	return durations
+}
+
+// PhaseTimer は現在のフェーズの持ち時間
+type PhaseTimer struct {
+	Phase Phase `json:"phase"`
+	// 延長分を含めた持ち時間
+	Duration time.Duration `json:"duration"`
+	// 動いている間の終了時刻
+	EndsAt time.Time `json:"endsAt"`
+	Paused bool      `json:"paused"`
+	// 一時停止中の残り時間
+	Left    time.Duration `json:"left"`
+	Expired bool          `json:"expired"`
+	// 出し終えた警告
+	Warned []time.Duration `json:"warned"`
+}
+
+func NewPhaseTimer(phase Phase, duration time.Duration, now time.Time) *PhaseTimer {
+	return &PhaseTimer{
+		Phase:    phase,
+		Duration: duration,
+		EndsAt:   now.Add(duration),
+	}
+}
+
+func (t *PhaseTimer) State() TimerState {
+	switch {
+	case t == nil:
+		return TimerNone
+	case t.Expired:
+		return TimerExpired
+	case t.Paused:
+		return TimerPaused
+	}
+	return TimerRunning
+}
+
+func (t *PhaseTimer) Remaining(now time.Time) time.Duration {
+	switch t.State() {
+	case TimerRunning:
+		return max(t.EndsAt.Sub(now), 0)
+	case TimerPaused:
+		return t.Left
+	}
+	return 0
+}
+
+func (t *PhaseTimer) Pause(now time.Time) error {
+	if t.State() != TimerRunning {
+		return ErrTimerNotRunning
+	}
+	t.Left = t.Remaining(now)
+	t.Paused = true
+	t.EndsAt = time.Time{}
+	return nil
+}
+
+func (t *PhaseTimer) Resume(now time.Time) error {
+	if t.State() != TimerPaused {
+		return ErrTimerNotPaused
+	}
+	t.EndsAt = now.Add(t.Left)
+	t.Paused = false
+	t.Left = 0
+	return nil
+}
+
+// Extend は持ち時間を延ばす。時間切れ後なら今から d だけ再開する
+// 延長で残り時間が戻った警告は、もう一度出せるようにする
+func (t *PhaseTimer) Extend(d time.Duration, now time.Time) error {
+	switch t.State() {
+	case TimerNone:
+		return ErrTimerNotRunning
+	case TimerExpired:
+		t.Expired = false
+		t.EndsAt = now.Add(d)
+	case TimerPaused:
+		t.Left += d
+	default:
+		t.EndsAt = t.EndsAt.Add(d)
+	}
+	t.Duration += d
+
+	remaining := t.Remaining(now)
+	t.Warned = slices.DeleteFunc(t.Warned, func(w time.Duration) bool {
+		return w < remaining
+	})
+	return nil
+}
+
+// DueWarnings は出すべき警告を返し、出したものとして記録する
+// 持ち時間以上の警告は最初から残り時間を下回っているので出さない
+func (t *PhaseTimer) DueWarnings(warnings []time.Duration, now time.Time) []time.Duration {
+	if t.State() != TimerRunning {
+		return nil
+	}
+
+	remaining := t.Remaining(now)
+	var due []time.Duration
+	for _, w := range warnings {
+		if w < t.Duration && remaining <= w && remaining > 0 && !slices.Contains(t.Warned, w) {
+			due = append(due, w)
+			t.Warned = append(t.Warned, w)
+		}
+	}
+	return due
+}
+
+// NextDeadline は次に警告か時間切れを処理すべき時刻を返す。止まっていれば false
+func (t *PhaseTimer) NextDeadline(warnings []time.Duration) (time.Time, bool) {
+	if t.State() != TimerRunning {
+		return time.Time{}, false
+	}
+
+	next := t.EndsAt
+	for _, w := range warnings {
+		at := t.EndsAt.Add(-w)
+		if w < t.Duration && !slices.Contains(t.Warned, w) && at.Before(next) {
+			next = at
+		}
+	}
+	return next, true
+}
+
+// EnterPhase はフェーズを切り替え、持ち時間のあるフェーズならタイマーを始める
+func (s *Session) EnterPhase(phase Phase, now time.Time) {
+	s.Phase = phase
+	s.Timer = nil
+	if d := s.TimerSettings.PhaseDurations[phase]; d > 0 {
+		s.Timer = NewPhaseTimer(phase, d, now)
+	}
+}
@@ -0,0 +1,123 @@
+package domain
+
+import "maps"
+
+// TieBreak は投票が同数で割れたときの決め方
+type TieBreak string
+
+const (
+	// 票を消してもう一度投票する
+	TieBreakRevote TieBreak = "revote"
+	// 同数の候補からホストが決める
+	TieBreakGM TieBreak = "gm"
+	// 誰も告発されず、犯人は逃げ切る
+	TieBreakCulpritEscapes TieBreak = "culprit_escapes"
+)
+
+func (t TieBreak) Valid() bool {
+	switch t {
+	case TieBreakRevote, TieBreakGM, TieBreakCulpritEscapes:
+		return true
+	}
+	return false
+}
+
+// MAX_VOTE_ROUNDS は再投票を含めた投票回数の上限。最後の回でも同数なら犯人は逃げ切る
+const MAX_VOTE_ROUNDS = 3
+
+// VoteDecision は告発先がどう決まったか
+type VoteDecision string
+
+const (
+	VoteDecisionMajority       VoteDecision = "majority"
+	VoteDecisionGM             VoteDecision = "gm"
+	VoteDecisionCulpritEscapes VoteDecision = "culprit_escapes"
+	// 誰も投票しなかった。犯人は逃げ切る
+	VoteDecisionNoVotes VoteDecision = "no_votes"
+)
+
+var (
+	ErrInvalidTieBreak   = NewError(ErrInvalidRequest, CodeInvalidRequest, "unknown tie break rule")
+	ErrVotingClosed      = NewError(ErrConflict, CodeWrongPhase, "votes can only be cast in the voting phase")
+	ErrVoteResultPending = NewError(ErrConflict, CodeWrongPhase, "vote result is available after the voting phase")
+	ErrVoteTied          = NewError(ErrConflict, CodeVoteTied, "the vote is tied and the host has not decided")
+	ErrVoteNotTied       = NewError(ErrConflict, CodeVoteTied, "the vote is not tied between the given role and another")
+	ErrTieBreakNotGM     = NewError(ErrConflict, CodeVoteTied, "tie break rule does not let the host decide")
+)
+
+// VoteResult は締め切った投票の結果
+type VoteResult struct {
+	Round int `json:"round"`
+	// 投票したプレイヤーID → 告発したロールID
+	Votes map[string]string `json:"votes"`
+	// ロールID → 得票数
+	Tally map[string]int `json:"tally"`
+	// 告発されたロール。犯人が逃げ切った場合は空
+	AccusedRoleID string       `json:"accusedRoleId"`
+	Decision      VoteDecision `json:"decision"`
+	CulpritID     string       `json:"culpritId"`
+	PlayersWin    bool         `json:"playersWin"`
+}
+
+func (s *Session) InVoting() bool {
+	return s.Phase == PhaseVoting
+}
+
+// TallyVotes はロールごとの得票数と、最多得票で並んだロールをシナリオの登場順で返す
+// 誰も投票していなければ並んだロールはない
+func (s *Session) TallyVotes() (map[string]int, []string) {
+	tally := make(map[string]int)
+	for _, roleID := range s.Votes {
+		tally[roleID]++
+	}
+
+	top := 0
+	for _, n := range tally {
+		top = max(top, n)
+	}
+	if top == 0 {
+		return tally, nil
+	}
+
+	var leaders []string
+	for _, c := range s.Scenario.Characters {
+		if tally[c.ID] == top {
+			leaders = append(leaders, c.ID)
+		}
+	}
+	return tally, leaders
+}
+
+// CloseVoting は投票を締め切って結果を返す
+// 同数で再投票になる場合は nil を返し、呼び出し側で票を消してやり直す
+// 再投票が MAX_VOTE_ROUNDS 回目に達しても同数なら、犯人は逃げ切る
+func (s *Session) CloseVoting() (*VoteResult, error) {
+	tally, leaders := s.TallyVotes()
+	result := &VoteResult{
+		Round:     s.VoteRound,
+		Votes:     maps.Clone(s.Votes),
+		Tally:     tally,
+		CulpritID: s.Scenario.Truth.CulpritID,
+	}
+
+	switch {
+	case len(leaders) == 0:
+		result.Decision = VoteDecisionNoVotes
+	case len(leaders) == 1:
+		result.AccusedRoleID = leaders[0]
+		result.Decision = VoteDecisionMajority
+	case s.TieBreak == TieBreakRevote && s.VoteRound < MAX_VOTE_ROUNDS:
+		return nil, nil
+	case s.TieBreak == TieBreakGM:
+		if s.TieDecision == "" {
+			return nil, ErrVoteTied
+		}
+		result.AccusedRoleID = s.TieDecision
+		result.Decision = VoteDecisionGM
+	default:
+		result.Decision = VoteDecisionCulpritEscapes
+	}
+
+	result.PlayersWin = result.AccusedRoleID != "" && result.AccusedRoleID == result.CulpritID
+	return result, nil
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func votingSession(tieBreak TieBreak, round int, votes map[string]string) *Session {
+	return &Session{
+		Phase: PhaseVoting,
+		Scenario: &Scenario{
+			Characters: []Character{{ID: "p1"}, {ID: "p2"}, {ID: "p3"}, {ID: "p4"}},
+			Truth:      Truth{CulpritID: "p2"},
+		},
+		TieBreak:  tieBreak,
+		Votes:     votes,
+		VoteRound: round,
+	}
+}
+
+func TestCloseVoting(t *testing.T) {
+	tied := map[string]string{"a": "p1", "b": "p1", "c": "p2", "d": "p2"}
+
+	tests := []struct {
+		name         string
+		tieBreak     TieBreak
+		round        int
+		votes        map[string]string
+		tieDecision  string
+		wantRevote   bool
+		wantErr      error
+		wantDecision VoteDecision
+		wantAccused  string
+		wantWin      bool
+	}{
+		{
+			name:     "majority catches the culprit",
+			tieBreak: TieBreakRevote, round: 1,
+			votes:        map[string]string{"a": "p2", "b": "p2", "c": "p1", "d": "p3"},
+			wantDecision: VoteDecisionMajority, wantAccused: "p2", wantWin: true,
+		},
+		{
+			name:     "majority accuses an innocent",
+			tieBreak: TieBreakGM, round: 1,
+			votes:        map[string]string{"a": "p1", "b": "p1", "c": "p2"},
+			wantDecision: VoteDecisionMajority, wantAccused: "p1",
+		},
+		{
+			name:     "tie revotes",
+			tieBreak: TieBreakRevote, round: 1,
+			votes:      tied,
+			wantRevote: true,
+		},
+		{
+			name:     "tie revotes again before the last round",
+			tieBreak: TieBreakRevote, round: MAX_VOTE_ROUNDS - 1,
+			votes:      tied,
+			wantRevote: true,
+		},
+		{
+			name:     "tie in the last round lets the culprit escape",
+			tieBreak: TieBreakRevote, round: MAX_VOTE_ROUNDS,
+			votes:        tied,
+			wantDecision: VoteDecisionCulpritEscapes,
+		},
+		{
+			name:     "tie waits for the host",
+			tieBreak: TieBreakGM, round: 1,
+			votes:   tied,
+			wantErr: ErrVoteTied,
+		},
+		{
+			name:     "host decides the tie",
+			tieBreak: TieBreakGM, round: 1,
+			votes: tied, tieDecision: "p2",
+			wantDecision: VoteDecisionGM, wantAccused: "p2", wantWin: true,
+		},
+		{
+			name:     "tie lets the culprit escape",
+			tieBreak: TieBreakCulpritEscapes, round: 1,
+			votes:        tied,
+			wantDecision: VoteDecisionCulpritEscapes,
+		},
+		{
+			name:     "no votes under revote",
+			tieBreak: TieBreakRevote, round: 1,
+			votes:        map[string]string{},
+			wantDecision: VoteDecisionNoVotes,
+		},
+		{
+			name:     "no votes under gm",
+			tieBreak: TieBreakGM, round: 1,
+			votes: map[string]string{}, tieDecision: "p2",
+			wantDecision: VoteDecisionNoVotes,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := votingSession(tt.tieBreak, tt.round, tt.votes)
+			s.TieDecision = tt.tieDecision
+
+			result, err := s.CloseVoting()
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.wantRevote {
+				if result != nil {
+					t.Fatalf("result = %+v, want a revote", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("result = nil, want a decision")
+			}
+			if result.Decision != tt.wantDecision || result.AccusedRoleID != tt.wantAccused || result.PlayersWin != tt.wantWin {
+				t.Errorf("result = %s/%q/%v, want %s/%q/%v",
+					result.Decision, result.AccusedRoleID, result.PlayersWin, tt.wantDecision, tt.wantAccused, tt.wantWin)
+			}
+			if result.Round != tt.round {
+				t.Errorf("round = %d, want %d", result.Round, tt.round)
+			}
+		})
+	}
+}
+
+// 再投票を繰り返しても、MAX_VOTE_ROUNDS 回目で必ず決着する
+func TestCloseVotingRepeatedRevotes(t *testing.T) {
+	s := votingSession(TieBreakRevote, 1, nil)
+	for {
+		s.Votes = map[string]string{"a": "p1", "b": "p3"}
+		result, err := s.CloseVoting()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != nil {
+			if result.Decision != VoteDecisionCulpritEscapes || result.Round != MAX_VOTE_ROUNDS {
+				t.Errorf("result = %s in round %d, want %s in round %d",
+					result.Decision, result.Round, VoteDecisionCulpritEscapes, MAX_VOTE_ROUNDS)
+			}
+			return
+		}
+		if s.VoteRound >= MAX_VOTE_ROUNDS {
+			t.Fatalf("still revoting in round %d", s.VoteRound)
+		}
+		s.VoteRound++
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	s := votingSession(TieBreakRevote, 1, map[string]string{"a": "p3", "b": "p1", "c": "p3", "d": "p1"})
+	tally, leaders := s.TallyVotes()
+	if tally["p1"] != 2 || tally["p3"] != 2 || len(tally) != 2 {
+		t.Errorf("tally = %v", tally)
+	}
+	// シナリオの登場順に並ぶ
+	if !slices.Equal(leaders, []string{"p1", "p3"}) {
+		t.Errorf("leaders = %v, want [p1 p3]", leaders)
+	}
+
+	s.Votes = map[string]string{}
+	if _, leaders := s.TallyVotes(); leaders != nil {
+		t.Errorf("leaders without votes = %v, want none", leaders)
+	}
+}
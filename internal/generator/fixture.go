@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed testdata/fixture_scenario.json
+var fixtureScenario []byte
+
+// FixtureGenerator は常に同じシナリオを返す。テストやローカル開発用
+type FixtureGenerator struct{}
+
+func NewFixtureGenerator() *FixtureGenerator {
+	return &FixtureGenerator{}
+}
+
+// Generate は5人用のシナリオを playerCount と difficulty に合わせて切り詰めて返す
+// テーマや言語などの指定は meta に書き込むだけで、本文は変わらない
+func (g *FixtureGenerator) Generate(ctx context.Context, req Request) ([]byte, error) {
+	var scenario map[string]any
+	if err := json.Unmarshal(fixtureScenario, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fixture: %w", err)
+	}
+
+	characters := scenario["characters"].([]any)
+	playerCount := req.PlayerCount
+	if playerCount <= 0 || playerCount > len(characters) {
+		playerCount = len(characters)
+	}
+
+	meta := scenario["meta"].(map[string]any)
+	meta["playerCount"] = playerCount
+	if req.Difficulty != "" {
+		meta["difficulty"] = req.Difficulty
+	}
+	for key, value := range map[string]string{
+		"theme":    req.Theme,
+		"era":      req.Era,
+		"tone":     req.Tone,
+		"language": req.Language,
+	} {
+		if value != "" {
+			meta[key] = value
+		}
+	}
+	if len(req.ContentRestrictions) > 0 {
+		meta["contentRestrictions"] = req.ContentRestrictions
+	}
+
+	// 犯人は p2 なので、後ろのキャラクターから削っても整合性は崩れない
+	scenario["characters"] = characters[:playerCount]
+	phases := scenario["phases"].(map[string]any)
+	for _, name := range []string{"investigation1", "investigation2"} {
+		privateInfo := phases[name].(map[string]any)["privateInfo"].(map[string]any)
+		for i := playerCount; i < len(characters); i++ {
+			delete(privateInfo, fmt.Sprintf("p%d", i+1))
+		}
+	}
+	remaining := make(map[string]bool, playerCount)
+	for _, c := range characters[:playerCount] {
+		remaining[c.(map[string]any)["id"].(string)] = true
+	}
+	for _, c := range characters[:playerCount] {
+		character := c.(map[string]any)
+		knowledge, ok := character["knowledge"].([]any)
+		if !ok {
+			continue
+		}
+		var kept []any
+		for _, k := range knowledge {
+			if remaining[k.(map[string]any)["aboutRoleId"].(string)] {
+				kept = append(kept, k)
+			}
+		}
+		character["knowledge"] = kept
+	}
+	var clues []any
+	for _, c := range scenario["clues"].([]any) {
+		clue := c.(map[string]any)
+		if clue["targetType"] == "character" && !remaining[clue["target"].(string)] {
+			continue
+		}
+		clues = append(clues, clue)
+	}
+	scenario["clues"] = clues
+
+	return json.Marshal(scenario)
+}
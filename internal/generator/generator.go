@@ -0,0 +1,38 @@
+package generator
+
+import "context"
+
+// Request はシナリオ生成に渡すパラメータ
+type Request struct {
+	PlayerCount int
+	Difficulty  string
+	Theme       string
+	Era         string
+	// comedic または serious
+	Tone string
+	// no_gore など、含めてはいけない表現
+	ContentRestrictions []string
+	// BCP 47 の言語タグ
+	Language string
+
+	// Correction が設定されている場合、前回の出力を違反内容に沿って修正させる
+	Correction *Correction
+}
+
+// Correction は前回の生成結果とその検証エラー
+type Correction struct {
+	Previous   []byte
+	Violations []Violation
+}
+
+// Violation はスキーマ検証で見つかった1件の違反
+type Violation struct {
+	InstancePath string `json:"instancePath"`
+	Keyword      string `json:"keyword"`
+	Message      string `json:"message"`
+}
+
+// ScenarioGenerator はシナリオの生JSONを生成するバックエンド
+type ScenarioGenerator interface {
+	Generate(ctx context.Context, req Request) ([]byte, error)
+}
@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_OPENAI_BASE_URL = "https://api.openai.com/v1"
+	DEFAULT_OPENAI_MODEL    = "gpt-4o-mini"
+	DEFAULT_OPENAI_TIMEOUT  = 120 * time.Second
+)
+
+type OpenAIConfig struct {
+	BaseURL    string
+	APIKey     string
+	Model      string
+	SchemaPath string
+	Timeout    time.Duration
+}
+
+// OpenAIGenerator は OpenAI 互換の chat completions API でシナリオを生成する
+type OpenAIGenerator struct {
+	baseURL string
+	apiKey  string
+	model   string
+	schema  string
+	client  *http.Client
+}
+
+func NewOpenAIGenerator(cfg OpenAIConfig) (*OpenAIGenerator, error) {
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("openai api key is required")
+	}
+
+	schemaBytes, err := os.ReadFile(cfg.SchemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = DEFAULT_OPENAI_BASE_URL
+	}
+	model := cfg.Model
+	if model == "" {
+		model = DEFAULT_OPENAI_MODEL
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DEFAULT_OPENAI_TIMEOUT
+	}
+
+	return &OpenAIGenerator{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  cfg.APIKey,
+		model:   model,
+		schema:  string(schemaBytes),
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type responseFormat struct {
+	Type string `json:"type"`
+}
+
+type chatRequest struct {
+	Model          string         `json:"model"`
+	Messages       []chatMessage  `json:"messages"`
+	ResponseFormat responseFormat `json:"response_format"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (g *OpenAIGenerator) Generate(ctx context.Context, req Request) ([]byte, error) {
+	messages := []chatMessage{
+		{Role: "system", Content: g.systemPrompt()},
+		{Role: "user", Content: userPrompt(req)},
+	}
+	if req.Correction != nil {
+		messages = append(messages,
+			chatMessage{Role: "assistant", Content: string(req.Correction.Previous)},
+			chatMessage{Role: "user", Content: correctionPrompt(req.Correction.Violations)},
+		)
+	}
+
+	return g.complete(ctx, messages)
+}
+
+func (g *OpenAIGenerator) complete(ctx context.Context, messages []chatMessage) ([]byte, error) {
+	body, err := json.Marshal(chatRequest{
+		Model:          g.model,
+		Messages:       messages,
+		ResponseFormat: responseFormat{Type: "json_object"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal chat request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build chat request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+g.apiKey)
+
+	res, err := g.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call chat completions: %w", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chat response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("chat completions returned %d: %s", res.StatusCode, resBody)
+	}
+
+	var chatRes chatResponse
+	if err := json.Unmarshal(resBody, &chatRes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chat response: %w", err)
+	}
+	if len(chatRes.Choices) == 0 {
+		return nil, fmt.Errorf("chat response has no choices")
+	}
+
+	return []byte(chatRes.Choices[0].Message.Content), nil
+}
+
+func (g *OpenAIGenerator) systemPrompt() string {
+	return "You are a writer of murder mystery party games. " +
+		"Respond with a single JSON object that conforms to the following JSON Schema. " +
+		"Do not wrap it in Markdown and do not add any commentary.\n\n" + g.schema
+}
+
+var contentRestrictionPrompts = map[string]string{
+	"no_gore":           "no graphic descriptions of wounds, blood or bodies",
+	"no_sexual_content": "no sexual content or innuendo",
+	"no_self_harm":      "no suicide or self-harm, not even as a red herring",
+}
+
+func userPrompt(req Request) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Create a murder mystery scenario for %d players.\n", req.PlayerCount)
+	fmt.Fprintf(&b, "Difficulty: %s\n", req.Difficulty)
+	if req.Theme != "" {
+		fmt.Fprintf(&b, "Theme: %s\n", req.Theme)
+	}
+	if req.Era != "" {
+		fmt.Fprintf(&b, "Era: %s\n", req.Era)
+	}
+	if req.Tone != "" {
+		fmt.Fprintf(&b, "Tone: %s\n", req.Tone)
+	}
+	for _, r := range req.ContentRestrictions {
+		if text, ok := contentRestrictionPrompts[r]; ok {
+			fmt.Fprintf(&b, "Content restriction: %s\n", text)
+		}
+	}
+	if req.Language != "" {
+		fmt.Fprintf(&b, "Write all texts in this language: %s\n", req.Language)
+	}
+	b.WriteString("Set meta.playerCount and meta.difficulty to the values above, and copy each of theme, era, tone, contentRestrictions and language that was given into meta verbatim.\n")
+	return b.String()
+}
+
+func correctionPrompt(violations []Violation) string {
+	var b strings.Builder
+	b.WriteString("The JSON above does not conform to the schema. Fix the following violations and respond with the full corrected JSON object.\n")
+	for _, v := range violations {
+		fmt.Fprintf(&b, "- at %q (%s): %s\n", v.InstancePath, v.Keyword, v.Message)
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/service"
+)
+
+// toRoleSummary はキャラクターの公開情報だけを返す。秘密や目的は含めない
+func toRoleSummary(c *domain.Character) *api.RoleSummary {
+	if c == nil {
+		return nil
+	}
+	return &api.RoleSummary{
+		Id:            c.ID,
+		Name:          c.Name,
+		PublicProfile: c.PublicProfile,
+	}
+}
+
+func toLobbyPlayer(session *domain.Session, player *domain.Player) api.LobbyPlayer {
+	role, _ := session.Scenario.Character(player.RoleID)
+	return api.LobbyPlayer{
+		PlayerId:   player.ID,
+		PlayerName: player.Name,
+		Ready:      player.Ready,
+		IsHost:     session.IsHost(player.ID),
+		Role:       toRoleSummary(role),
+	}
+}
+
+func toLobbyResponse(session *domain.Session) api.LobbyResponse {
+	resp := api.LobbyResponse{
+		SessionId:      session.ID,
+		JoinCode:       session.JoinCode,
+		Title:          session.Scenario.Setting.Title,
+		Phase:          api.LobbyResponsePhase(session.Phase),
+		HostId:         session.HostID,
+		PlayerCount:    session.Scenario.Meta.PlayerCount,
+		RoleAssignment: api.LobbyResponseRoleAssignment(session.RoleAssignment),
+		Players:        make([]api.LobbyPlayer, 0, len(session.Players)),
+		OpenRoles:      []api.RoleSummary{},
+	}
+	for _, playerID := range session.PlayerIDs() {
+		resp.Players = append(resp.Players, toLobbyPlayer(session, session.Players[playerID]))
+	}
+	for _, roleID := range session.OpenRoles() {
+		role, _ := session.Scenario.Character(roleID)
+		resp.OpenRoles = append(resp.OpenRoles, *toRoleSummary(role))
+	}
+	return resp
+}
+
+func toVoteResult(result *domain.VoteResult) api.VoteResult {
+	resp := api.VoteResult{
+		Round:      result.Round,
+		Votes:      result.Votes,
+		Tally:      result.Tally,
+		Decision:   api.VoteResultDecision(result.Decision),
+		CulpritId:  result.CulpritID,
+		PlayersWin: result.PlayersWin,
+	}
+	if result.AccusedRoleID != "" {
+		resp.AccusedRoleId = &result.AccusedRoleID
+	}
+	return resp
+}
+
+func toClueCard(card *service.ClueCard) api.ClueCard {
+	resp := api.ClueCard{
+		Id:         card.ID,
+		Phase:      api.ClueCardPhase(card.Phase),
+		TargetType: api.ClueCardTargetType(card.TargetType),
+		Target:     card.Target,
+		Title:      card.Title,
+		Text:       card.Text,
+		HolderId:   card.State.HolderID,
+		Revealed:   card.State.Revealed,
+
+		NonTransferable: card.NonTransferable,
+	}
+	if card.State.History != nil {
+		history := make([]api.ClueHolding, 0, len(card.State.History))
+		for _, h := range card.State.History {
+			history = append(history, api.ClueHolding{PlayerId: h.PlayerID, Since: h.Since})
+		}
+		resp.History = &history
+	}
+	return resp
+}
+
+func toClueOffer(offer *domain.ClueOffer) api.ClueOffer {
+	return api.ClueOffer{
+		OfferId:      offer.ID,
+		ClueId:       offer.ClueID,
+		FromPlayerId: offer.FromID,
+		ToPlayerId:   offer.ToID,
+		Status:       api.ClueOfferStatus(offer.Status),
+		CreatedAt:    offer.CreatedAt,
+		ResolvedAt:   offer.ResolvedAt,
+	}
+}
+
+func toTimerSettings(req api.TimerSettings) domain.TimerSettings {
+	var settings domain.TimerSettings
+	if req.PhaseMinutes != nil {
+		settings.PhaseDurations = make(map[domain.Phase]time.Duration, len(*req.PhaseMinutes))
+		for phase, minutes := range *req.PhaseMinutes {
+			settings.PhaseDurations[domain.Phase(phase)] = time.Duration(minutes) * time.Minute
+		}
+	}
+	if req.WarningSeconds != nil {
+		settings.Warnings = make([]time.Duration, 0, len(*req.WarningSeconds))
+		for _, seconds := range *req.WarningSeconds {
+			settings.Warnings = append(settings.Warnings, time.Duration(seconds)*time.Second)
+		}
+	}
+	if req.AutoAdvance != nil {
+		settings.AutoAdvance = *req.AutoAdvance
+	}
+	return settings
+}
+
+func toTimerResponse(view *service.TimerView) api.TimerResponse {
+	resp := api.TimerResponse{
+		Phase:            string(view.Phase),
+		State:            api.TimerResponseState(view.State),
+		DurationSeconds:  int(view.Duration / time.Second),
+		RemainingSeconds: int(view.Remaining / time.Second),
+		EndsAt:           view.EndsAt,
+		AutoAdvance:      view.AutoAdvance,
+		WarningSeconds:   make([]int, 0, len(view.Warnings)),
+	}
+	for _, w := range view.Warnings {
+		resp.WarningSeconds = append(resp.WarningSeconds, int(w/time.Second))
+	}
+	return resp
+}
+
+func toScenarioSummary(s *domain.LibraryScenario) api.ScenarioSummary {
+	return api.ScenarioSummary{
+		Id:          s.ID,
+		Title:       s.Title,
+		PlayerCount: s.PlayerCount,
+		Difficulty:  s.Difficulty,
+		Source:      api.ScenarioSummarySource(s.Source),
+		PlayCount:   s.PlayCount,
+		CreatedAt:   s.CreatedAt,
+	}
+}
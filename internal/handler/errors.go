@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+const PROBLEM_CONTENT_TYPE = "application/problem+json"
+
+// エラーの種類ごとのHTTPステータス
+var statusByKind = []struct {
+	kind   error
+	status int
+}{
+	{domain.ErrInvalidRequest, http.StatusBadRequest},
+	{domain.ErrUnauthorized, http.StatusUnauthorized},
+	{domain.ErrForbidden, http.StatusForbidden},
+	{domain.ErrNotFound, http.StatusNotFound},
+	{domain.ErrInvalidPhaseTransition, http.StatusConflict},
+	{domain.ErrSessionFull, http.StatusConflict},
+	{domain.ErrConflict, http.StatusConflict},
+	{domain.ErrUpstream, http.StatusBadGateway},
+}
+
+// HTTPErrorHandler はハンドラが返したエラーを Problem 形式のレスポンスに変換する
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	problem := toProblem(err)
+	if problem.Status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	if err := writeProblem(c, problem); err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+func writeProblem(c echo.Context, problem api.Problem) error {
+	c.Response().Header().Set(echo.HeaderContentType, PROBLEM_CONTENT_TYPE)
+	if c.Request().Method == http.MethodHead {
+		return c.NoContent(problem.Status)
+	}
+	return c.JSON(problem.Status, problem)
+}
+
+func toProblem(err error) api.Problem {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		for _, m := range statusByKind {
+			if errors.Is(domainErr, m.kind) {
+				return newProblem(m.status, api.ProblemCode(domainErr.Code), domainErr.Message)
+			}
+		}
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		detail := http.StatusText(httpErr.Code)
+		if msg, ok := httpErr.Message.(string); ok {
+			detail = msg
+		}
+		return newProblem(httpErr.Code, codeForStatus(httpErr.Code), detail)
+	}
+
+	return newProblem(http.StatusInternalServerError, api.ProblemCodeInternalError, "internal server error")
+}
+
+func codeForStatus(status int) api.ProblemCode {
+	switch status {
+	case http.StatusBadRequest:
+		return api.ProblemCodeInvalidRequest
+	case http.StatusUnauthorized:
+		return api.ProblemCodeUnauthorized
+	case http.StatusForbidden:
+		return api.ProblemCodeForbidden
+	case http.StatusNotFound:
+		return api.ProblemCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return api.ProblemCodeMethodNotAllowed
+	}
+	return api.ProblemCodeInternalError
+}
+
+func newProblem(status int, code api.ProblemCode, detail string) api.Problem {
+	return api.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: &detail,
+		Code:   code,
+	}
+}
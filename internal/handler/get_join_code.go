@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /join/{code}
+func (s *Server) GetJoinCode(c echo.Context, code string) error {
+	session, err := s.SessionS.FindByJoinCode(c.Request().Context(), code)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, api.JoinCodeResponse{
+		SessionId: session.ID,
+		JoinCode:  session.JoinCode,
+	})
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /scenarios/{scenarioId}
+// 真相や秘密はゲーム前に見せられないので、公開してよい項目だけを返す
+// トークンなしで公開するので、返す項目を増やす時は公開してよいか確かめる
+func (s *Server) GetScenario(c echo.Context, scenarioId string) error {
+	entry, err := s.ScenarioS.GetScenario(c.Request().Context(), scenarioId)
+	if err != nil {
+		return err
+	}
+
+	scenario := entry.Scenario
+	summary := toScenarioSummary(entry)
+	resp := api.ScenarioResponse{
+		Id:                   summary.Id,
+		Title:                summary.Title,
+		PlayerCount:          summary.PlayerCount,
+		Difficulty:           summary.Difficulty,
+		Source:               api.ScenarioResponseSource(summary.Source),
+		PlayCount:            summary.PlayCount,
+		CreatedAt:            summary.CreatedAt,
+		EstimatedTimeMinutes: scenario.Meta.EstimatedTimeMinutes,
+		WorldDescription:     scenario.Setting.WorldDescription,
+		IncidentDescription:  scenario.Setting.IncidentDescription,
+		Characters:           make([]api.RoleSummary, 0, len(scenario.Characters)),
+	}
+	for i := range scenario.Characters {
+		resp.Characters = append(resp.Characters, *toRoleSummary(&scenario.Characters[i]))
+	}
+
+	params := scenario.Meta.GenerationParams
+	if params.Theme != "" {
+		resp.Theme = &params.Theme
+	}
+	if params.Era != "" {
+		resp.Era = &params.Era
+	}
+	if params.Tone != "" {
+		tone := string(params.Tone)
+		resp.Tone = &tone
+	}
+	if len(params.ContentRestrictions) > 0 {
+		restrictions := make([]string, 0, len(params.ContentRestrictions))
+		for _, r := range params.ContentRestrictions {
+			restrictions = append(restrictions, string(r))
+		}
+		resp.ContentRestrictions = &restrictions
+	}
+	if params.Language != "" {
+		resp.Language = &params.Language
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
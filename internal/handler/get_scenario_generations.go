@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /scenario-generations
+// 生成の失敗理由には運用の情報が含まれるので、管理用の鍵を要求する
+func (s *Server) GetScenarioGenerations(c echo.Context) error {
+	if err := s.Operator.Require(c); err != nil {
+		return err
+	}
+
+	history := s.ScenarioS.GenerationHistory()
+	resp := api.GenerationHistoryResponse{
+		Total:   len(history),
+		Records: make([]api.GenerationRecord, 0, len(history)),
+	}
+	for _, record := range history {
+		if record.Succeeded {
+			resp.Succeeded++
+			if len(record.Attempts) > 1 {
+				resp.Repaired++
+			}
+		}
+
+		attempts := make([]api.GenerationAttempt, 0, len(record.Attempts))
+		for _, a := range record.Attempts {
+			attempt := api.GenerationAttempt{
+				Attempt:    a.Attempt,
+				Violations: make([]api.Violation, 0, len(a.Violations)),
+			}
+			for _, v := range a.Violations {
+				attempt.Violations = append(attempt.Violations, api.Violation{
+					InstancePath: v.InstancePath,
+					Keyword:      v.Keyword,
+					Message:      v.Message,
+				})
+			}
+			if a.Err != "" {
+				attempt.Error = &a.Err
+			}
+			attempts = append(attempts, attempt)
+		}
+		resp.Records = append(resp.Records, api.GenerationRecord{
+			StartedAt: record.StartedAt,
+			Succeeded: record.Succeeded,
+			Attempts:  attempts,
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
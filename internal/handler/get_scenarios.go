@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /scenarios
+// ホストがセッションを作る前に選べるよう、トークンなしで公開する
+func (s *Server) GetScenarios(c echo.Context) error {
+	scenarios, err := s.ScenarioS.ListScenarios(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	resp := api.ScenarioListResponse{
+		Scenarios: make([]api.ScenarioSummary, 0, len(scenarios)),
+	}
+	for _, scenario := range scenarios {
+		resp.Scenarios = append(resp.Scenarios, toScenarioSummary(scenario))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}
+func (s *Server) GetSession(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.SessionS.GetLobby(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toLobbyResponse(session))
+}
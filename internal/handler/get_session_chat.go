@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/service"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	WS_WRITE_TIMEOUT  = 10 * time.Second
+	WS_PONG_TIMEOUT   = 60 * time.Second
+	WS_PING_INTERVAL  = 30 * time.Second
+	WS_MAX_FRAME_SIZE = 8 * 1024
+)
+
+var upgrader = websocket.Upgrader{
+	// ネイティブアプリや別オリジンのフロントエンドから接続するため、認証はトークンで行う
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// ChatClientFrame はクライアントから送られるフレーム
+type ChatClientFrame struct {
+	Kind     domain.ChatKind `json:"kind"`
+	ToRoleID string          `json:"toRoleId,omitempty"`
+	Text     string          `json:"text"`
+}
+
+// ChatServerFrame はサーバーから送るフレーム
+type ChatServerFrame struct {
+	Type    string              `json:"type"`
+	Message *domain.ChatMessage `json:"message,omitempty"`
+	Error   *api.Problem        `json:"error,omitempty"`
+}
+
+// GET /sessions/{sessionId}/chat
+func (s *Server) GetSessionChat(c echo.Context, sessionId string, params api.GetSessionChatParams) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var after int64
+	if params.After != nil {
+		after = *params.After
+	}
+
+	// 履歴の取得中に届いたメッセージを取りこぼさないよう、先に購読する
+	ctx := c.Request().Context()
+	_, events, cancel, err := s.SessionS.SubscribeEvents(ctx, sessionId, claims.Subject, math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	history, err := s.SessionS.ChatHistory(ctx, sessionId, claims.Subject, after)
+	if err != nil {
+		return err
+	}
+
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// Upgrade が応答を書き込み済み
+		return nil
+	}
+	defer conn.Close()
+
+	replies := make(chan ChatServerFrame)
+	closed := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go s.readChat(ctx, conn, sessionId, claims.Subject, replies, closed, done)
+
+	for i := range history {
+		if err := writeFrame(conn, ChatServerFrame{Type: "message", Message: &history[i]}); err != nil {
+			return nil
+		}
+		after = history[i].ID
+	}
+
+	ping := time.NewTicker(WS_PING_INTERVAL)
+	defer ping.Stop()
+
+	for {
+		var frame ChatServerFrame
+		select {
+		case <-closed:
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			message, isChat := event.Data.(*domain.ChatMessage)
+			if event.Type != service.EventChatMessage || !isChat || message.ID <= after {
+				continue
+			}
+			frame = ChatServerFrame{Type: "message", Message: message}
+		case frame = <-replies:
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(WS_WRITE_TIMEOUT)); err != nil {
+				return nil
+			}
+			continue
+		}
+
+		if err := writeFrame(conn, frame); err != nil {
+			return nil
+		}
+	}
+}
+
+// readChat はクライアントからのフレームを投稿し、失敗したらエラーフレームを返す
+// 接続が切れたら closed を閉じる。書き込み側が終了したら done が閉じられる
+func (s *Server) readChat(
+	ctx context.Context,
+	conn *websocket.Conn,
+	sessionID string,
+	senderID string,
+	replies chan<- ChatServerFrame,
+	closed chan<- struct{},
+	done <-chan struct{},
+) {
+	defer close(closed)
+
+	conn.SetReadLimit(WS_MAX_FRAME_SIZE)
+	conn.SetReadDeadline(time.Now().Add(WS_PONG_TIMEOUT))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(WS_PONG_TIMEOUT))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var frame ChatClientFrame
+		if err := json.Unmarshal(data, &frame); err != nil {
+			err = domain.NewError(domain.ErrInvalidRequest, domain.CodeInvalidRequest, "frame must be a JSON object")
+			problem := toProblem(err)
+			select {
+			case replies <- ChatServerFrame{Type: "error", Error: &problem}:
+			case <-done:
+				return
+			}
+			continue
+		}
+
+		_, err = s.SessionS.PostMessage(ctx, sessionID, senderID, frame.Kind, frame.ToRoleID, frame.Text)
+		if err != nil {
+			problem := toProblem(err)
+			select {
+			case replies <- ChatServerFrame{Type: "error", Error: &problem}:
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+func writeFrame(conn *websocket.Conn, frame ChatServerFrame) error {
+	conn.SetWriteDeadline(time.Now().Add(WS_WRITE_TIMEOUT))
+	return conn.WriteJSON(frame)
+}
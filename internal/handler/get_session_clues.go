@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/clues
+func (s *Server) GetSessionClues(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	cards, err := s.SessionS.ListClues(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	resp := api.ClueListResponse{
+		Clues: make([]api.ClueCard, 0, len(cards)),
+	}
+	for i := range cards {
+		resp.Clues = append(resp.Clues, toClueCard(&cards[i]))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
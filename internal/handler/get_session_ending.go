@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/ending
+func (s *Server) GetSessionEnding(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	ending, err := s.SessionS.GetEnding(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	resp := api.EndingResponse{
+		GmText: ending.GmText,
+		Truth: api.Truth{
+			CulpritId:   ending.Truth.CulpritID,
+			Motive:      ending.Truth.Motive,
+			Method:      ending.Truth.Method,
+			Timeline:    ending.Truth.Timeline,
+			RedHerrings: ending.Truth.RedHerrings,
+		},
+		Players: make([]api.PlayerOutcome, 0, len(ending.Outcomes)),
+	}
+	if ending.VoteResult != nil {
+		vote := toVoteResult(ending.VoteResult)
+		resp.Vote = &vote
+	}
+	for _, o := range ending.Outcomes {
+		outcome := api.PlayerOutcome{
+			PlayerId:     o.Player.ID,
+			PlayerName:   o.Player.Name,
+			Role:         toRoleSummary(o.Character),
+			IsCulprit:    o.IsCulprit,
+			Accused:      o.Accused,
+			TeamWon:      o.TeamWon,
+			GoalAchieved: o.GoalAchieved,
+			Won:          o.Won,
+		}
+		if o.Character != nil {
+			outcome.Secret = o.Character.Secret
+			outcome.PersonalGoal = o.Character.PersonalGoal
+		}
+		resp.Players = append(resp.Players, outcome)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
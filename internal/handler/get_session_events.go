@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// 中継サーバーにアイドル切断されないよう送るコメント行の間隔
+const SSE_HEARTBEAT_INTERVAL = 15 * time.Second
+
+// GET /sessions/{sessionId}/events
+func (s *Server) GetSessionEvents(c echo.Context, sessionId string, params api.GetSessionEventsParams) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var lastEventID int64
+	if params.LastEventID != nil {
+		lastEventID, err = strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			return domain.NewError(domain.ErrInvalidRequest, domain.CodeInvalidRequest, "Last-Event-ID must be an integer")
+		}
+	}
+
+	ctx := c.Request().Context()
+	replay, events, cancel, err := s.SessionS.SubscribeEvents(ctx, sessionId, claims.Subject, lastEventID)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+
+	for _, event := range replay {
+		if err := writeSSE(res, event); err != nil {
+			return nil
+		}
+	}
+	res.Flush()
+
+	heartbeat := time.NewTicker(SSE_HEARTBEAT_INTERVAL)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				// 配信が追いつかないか、セッションが長く動いていないため購読が切られた。クライアントは Last-Event-ID で再接続する
+				return nil
+			}
+			if err := writeSSE(res, event); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		}
+		res.Flush()
+	}
+}
+
+func writeSSE(res *echo.Response, event service.Event) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/booklet"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/handouts
+func (s *Server) GetSessionHandouts(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	scenario, err := s.SessionS.HandoutScenario(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	// 途中で失敗しても Problem を返せるよう、書き終えてから送る
+	var buf bytes.Buffer
+	if err := booklet.WriteHandouts(&buf, scenario); err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="handouts-%s.zip"`, sessionId))
+	return c.Blob(http.StatusOK, "application/zip", buf.Bytes())
+}
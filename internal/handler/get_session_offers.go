@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/offers
+func (s *Server) GetSessionOffers(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	offers, err := s.SessionS.ListOffers(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	resp := api.ClueOfferListResponse{
+		Offers: make([]api.ClueOffer, 0, len(offers)),
+	}
+	for i := range offers {
+		resp.Offers = append(resp.Offers, toClueOffer(&offers[i]))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
@@ -4,14 +4,54 @@ import (
 	"net/http"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
 	"github.com/labstack/echo/v4"
 )
 
 // GET /sessions/{sessionId}/phase
-func (s *Server) GetSessionPhase(c echo.Context, sessionId string, params api.GetSessionPhaseParams) error {
+func (s *Server) GetSessionPhase(c echo.Context, sessionId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	view, err := s.SessionS.GetPhase(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
 	resp := api.PhaseResponse{
-		Phase:  api.PhaseResponsePhaseIntro,
-		GmText: "ゲームを開始します。",
+		Phase:       api.PhaseResponsePhase(view.Phase),
+		GmText:      view.GmText,
+		PublicInfo:  view.PublicInfo,
+		PrivateInfo: view.PrivateInfo,
+
+		ActionPointsLeft: view.ActionPointsLeft,
+	}
+	if view.ClueTargets != nil {
+		targets := make([]api.ClueTarget, 0, len(view.ClueTargets))
+		for _, t := range view.ClueTargets {
+			targets = append(targets, api.ClueTarget{
+				TargetType: api.ClueTargetTargetType(t.Type),
+				Target:     t.Target,
+				Remaining:  t.Remaining,
+			})
+		}
+		resp.ClueTargets = &targets
+	}
+	if view.Role != nil {
+		resp.Role = toRoleSummary(view.Role)
+		resp.Secret = &view.Role.Secret
+		resp.PersonalGoal = &view.Role.PersonalGoal
+		knowledge := make([]api.Knowledge, 0, len(view.Knowledge))
+		for _, k := range view.Knowledge {
+			knowledge = append(knowledge, api.Knowledge{
+				AboutRoleId: k.AboutRoleID,
+				Phase:       string(k.Phase),
+				Text:        k.Text,
+			})
+		}
+		resp.Knowledge = &knowledge
 	}
 
 	return c.JSON(http.StatusOK, resp)
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/timer
+func (s *Server) GetSessionTimer(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	view, err := s.SessionS.GetTimer(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toTimerResponse(view))
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// GET /sessions/{sessionId}/transitions
+func (s *Server) GetSessionTransitions(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	history, err := s.SessionS.PhaseHistory(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	resp := api.TransitionListResponse{
+		Transitions: make([]api.Transition, 0, len(history)),
+	}
+	for _, t := range history {
+		transition := api.Transition{
+			From:   string(t.From),
+			To:     string(t.To),
+			Kind:   api.TransitionKind(t.Kind),
+			Forced: t.Forced,
+			At:     t.At,
+		}
+		if t.ActorID != "" {
+			transition.ActorId = &t.ActorID
+		}
+		resp.Transitions = append(resp.Transitions, transition)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// POST /scenarios
+// 検証エラーの位置がずれないよう、本文はデコードせずそのまま検証に回す
+func (s *Server) PostScenarios(c echo.Context) error {
+	if err := s.Operator.Require(c); err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return err
+	}
+
+	scenario, err := s.ScenarioS.UploadScenario(c.Request().Context(), body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toScenarioSummary(scenario))
+}
@@ -4,13 +4,31 @@ import (
 	"net/http"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
 	"github.com/labstack/echo/v4"
 )
 
 // POST /sessions/{sessionId}/advance
 func (s *Server) PostSessionAdvance(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	// ボディは省略できる
+	var req api.AdvancePhaseRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	force := req.Force != nil && *req.Force
+
+	phase, err := s.SessionS.AdvancePhase(c.Request().Context(), sessionId, claims.Subject, force)
+	if err != nil {
+		return err
+	}
+
 	resp := api.AdvancePhaseResponse{
-		Phase: api.AdvancePhaseResponsePhaseIntro,
+		Phase: api.AdvancePhaseResponsePhase(phase),
 	}
 
 	return c.JSON(http.StatusOK, resp)
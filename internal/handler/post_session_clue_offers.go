@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/clues/{clueId}/offers
+func (s *Server) PostSessionClueOffers(c echo.Context, sessionId string, clueId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.ClueOfferRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	offer, err := s.SessionS.OfferClue(c.Request().Context(), sessionId, claims.Subject, clueId, req.ToPlayerId)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toClueOffer(offer))
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/clues/{clueId}/reveal
+func (s *Server) PostSessionClueReveal(c echo.Context, sessionId string, clueId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	card, err := s.SessionS.RevealClue(c.Request().Context(), sessionId, claims.Subject, clueId)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toClueCard(card))
+}
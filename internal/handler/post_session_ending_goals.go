@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/ending/goals
+func (s *Server) PostSessionEndingGoals(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.JudgeGoalRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := s.SessionS.JudgeGoal(c.Request().Context(), sessionId, claims.Subject, req.RoleId, req.Achieved); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/host
+func (s *Server) PostSessionHost(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.TransferHostRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	hostID, err := s.SessionS.TransferHost(c.Request().Context(), sessionId, claims.Subject, req.PlayerId)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, api.TransferHostResponse{
+		HostId: hostID,
+	})
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/investigations
+func (s *Server) PostSessionInvestigations(c echo.Context, sessionId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.InvestigateRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	card, left, err := s.SessionS.Investigate(
+		c.Request().Context(),
+		sessionId,
+		claims.Subject,
+		domain.ClueTargetType(req.TargetType),
+		req.Target,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, api.InvestigateResponse{
+		Clue:             toClueCard(card),
+		ActionPointsLeft: left,
+	})
+}
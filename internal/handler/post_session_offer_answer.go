@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/offers/{offerId}/accept
+func (s *Server) PostSessionOfferAccept(c echo.Context, sessionId string, offerId string) error {
+	return s.answerOffer(c, sessionId, offerId, true)
+}
+
+// POST /sessions/{sessionId}/offers/{offerId}/decline
+func (s *Server) PostSessionOfferDecline(c echo.Context, sessionId string, offerId string) error {
+	return s.answerOffer(c, sessionId, offerId, false)
+}
+
+func (s *Server) answerOffer(c echo.Context, sessionId string, offerId string, accept bool) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	offer, err := s.SessionS.AnswerOffer(c.Request().Context(), sessionId, claims.Subject, offerId, accept)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toClueOffer(offer))
+}
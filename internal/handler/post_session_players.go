@@ -4,21 +4,33 @@ import (
 	"net/http"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
 	"github.com/labstack/echo/v4"
 )
 
 func(s *Server) PostSessionPlayers(c echo.Context, sessionId string) error {
 	var req api.JoinPlayerRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, err)
+		return err
 	}
 
-	playerId := "player_dummy"
-	roleId := "p1"
+	player, role, err := s.SessionS.JoinPlayer(c.Request().Context(), sessionId, req.PlayerName)
+	if err != nil {
+		return err
+	}
+
+	token, err := s.Auth.Issue(sessionId, player.ID, auth.RolePlayer)
+	if err != nil {
+		return err
+	}
 
 	resp := api.JoinPlayerResponse{
-		PlayerId: playerId,
-		RoleId:   roleId,
+		PlayerId: player.ID,
+		Role:     toRoleSummary(role),
+		Token:    token,
+	}
+	if role != nil {
+		resp.RoleId = &role.ID
 	}
 
 	return c.JSON(http.StatusOK, resp)
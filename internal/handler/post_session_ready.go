@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/ready
+func (s *Server) PostSessionReady(c echo.Context, sessionId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.ReadyRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	session, err := s.SessionS.SetReady(c.Request().Context(), sessionId, claims.Subject, req.Ready)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toLobbyPlayer(session, session.Players[claims.Subject]))
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/rewind
+func (s *Server) PostSessionRewind(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	phase, err := s.SessionS.RewindPhase(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, api.AdvancePhaseResponse{
+		Phase: api.AdvancePhaseResponsePhase(phase),
+	})
+}
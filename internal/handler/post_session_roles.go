@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/roles
+func (s *Server) PostSessionRoles(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.DealRolesRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	var assignments map[string]string
+	if req.Assignments != nil {
+		assignments = *req.Assignments
+	}
+
+	session, err := s.SessionS.DealRoles(c.Request().Context(), sessionId, claims.Subject, assignments)
+	if err != nil {
+		return err
+	}
+
+	resp := api.DealRolesResponse{
+		Players: make([]api.AssignedPlayer, 0, len(session.Players)),
+	}
+	for _, playerID := range session.PlayerIDs() {
+		player := session.Players[playerID]
+		role, _ := session.Scenario.Character(player.RoleID)
+		resp.Players = append(resp.Players, api.AssignedPlayer{
+			PlayerId:   player.ID,
+			PlayerName: player.Name,
+			Role:       *toRoleSummary(role),
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/timer/pause
+func (s *Server) PostSessionTimerPause(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	view, err := s.SessionS.PauseTimer(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toTimerResponse(view))
+}
+
+// POST /sessions/{sessionId}/timer/resume
+func (s *Server) PostSessionTimerResume(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	view, err := s.SessionS.ResumeTimer(c.Request().Context(), sessionId, claims.Subject)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toTimerResponse(view))
+}
+
+// POST /sessions/{sessionId}/timer/extend
+func (s *Server) PostSessionTimerExtend(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.ExtendTimerRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	view, err := s.SessionS.ExtendTimer(c.Request().Context(), sessionId, claims.Subject, time.Duration(req.Seconds)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, toTimerResponse(view))
+}
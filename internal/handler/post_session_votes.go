@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// POST /sessions/{sessionId}/votes
+func (s *Server) PostSessionVotes(c echo.Context, sessionId string) error {
+	claims, err := auth.RequirePlayer(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.CastVoteRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := s.SessionS.CastVote(c.Request().Context(), sessionId, claims.Subject, req.AccusedRoleId); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/service"
 	"github.com/labstack/echo/v4"
 )
 
@@ -13,15 +16,54 @@ func(s *Server) PostSessions(c echo.Context) error {
 		return err
 	}
 
-	session, err := s.SessionS.CreateSession(
-		int(req.PlayerCount),
-		string(req.Difficulty),
-	)
+	opts := service.CreateSessionOptions{
+		PlayerCount: int(req.PlayerCount),
+		Difficulty:  string(req.Difficulty),
+	}
+	if req.RoleAssignment != nil {
+		opts.RoleAssignment = domain.RoleAssignment(*req.RoleAssignment)
+	}
+	if req.TieBreak != nil {
+		opts.TieBreak = domain.TieBreak(*req.TieBreak)
+	}
+	if req.ScenarioId != nil {
+		opts.ScenarioID = *req.ScenarioId
+	}
+	if req.Theme != nil {
+		opts.Params.Theme = *req.Theme
+	}
+	if req.Era != nil {
+		opts.Params.Era = *req.Era
+	}
+	if req.Tone != nil {
+		opts.Params.Tone = domain.Tone(*req.Tone)
+	}
+	if req.ContentRestrictions != nil {
+		for _, r := range *req.ContentRestrictions {
+			opts.Params.ContentRestrictions = append(opts.Params.ContentRestrictions, domain.ContentRestriction(r))
+		}
+	}
+	if req.Language != nil {
+		opts.Params.Language = *req.Language
+	}
+	if req.Timer != nil {
+		opts.TimerSettings = toTimerSettings(*req.Timer)
+	}
+
+	session, err := s.SessionS.CreateSession(c.Request().Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	hostToken, err := s.Auth.Issue(session.ID, session.HostID, auth.RoleHost)
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(http.StatusOK, api.CreateSessionResponse{
 		SessionId: session.ID,
+		JoinCode:  session.JoinCode,
+		HostId:    session.HostID,
+		HostToken: hostToken,
 	})
 }
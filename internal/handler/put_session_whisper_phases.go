@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+// PUT /sessions/{sessionId}/chat/whisper-phases
+func (s *Server) PutSessionWhisperPhases(c echo.Context, sessionId string) error {
+	claims, err := auth.Require(c)
+	if err != nil {
+		return err
+	}
+
+	var req api.WhisperPhases
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	phases := make([]domain.Phase, 0, len(req.Phases))
+	for _, p := range req.Phases {
+		phases = append(phases, domain.Phase(p))
+	}
+
+	phases, err = s.SessionS.SetWhisperPhases(c.Request().Context(), sessionId, claims.Subject, phases)
+	if err != nil {
+		return err
+	}
+
+	resp := api.WhisperPhases{
+		Phases: make([]api.WhisperPhasesPhases, 0, len(phases)),
+	}
+	for _, p := range phases {
+		resp.Phases = append(resp.Phases, api.WhisperPhasesPhases(p))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
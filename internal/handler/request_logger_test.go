@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
+	"github.com/labstack/echo/v4"
+)
+
+// クエリで渡したトークンは、認証に成功しても失敗してもログに残らない
+func TestRequestLoggerRedactsQueryToken(t *testing.T) {
+	signer := auth.NewSigner([]byte("secret"))
+	token, err := signer.Issue("session_1", "player_1", auth.RolePlayer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := "forged" + token
+
+	var logs bytes.Buffer
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	e.Use(RequestLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	e.Use(auth.Middleware(signer))
+	e.GET("/sessions/:sessionId/events", func(c echo.Context) error {
+		if _, err := auth.Require(c); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	for _, tt := range []struct {
+		token string
+		want  int
+	}{
+		{token, http.StatusNoContent},
+		{forged, http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/sessions/session_1/events?lastEventId=3&access_token="+tt.token, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+		}
+	}
+
+	out := logs.String()
+	if strings.Count(out, "access_token=REDACTED") != 2 {
+		t.Errorf("requests not logged with a redacted token:\n%s", out)
+	}
+	// トークンの署名部分が1か所でも出ていれば漏れている
+	_, signature, _ := strings.Cut(token, ".")
+	if strings.Contains(out, signature) {
+		t.Errorf("token leaked into the log:\n%s", out)
+	}
+	if !strings.Contains(out, "lastEventId=3") {
+		t.Errorf("other query parameters dropped:\n%s", out)
+	}
+}
@@ -2,24 +2,124 @@ package handler
 
 import (
 	"github.com/IamSBStakumi/mysterio_backend/internal/api"
+	"github.com/IamSBStakumi/mysterio_backend/internal/auth"
 	"github.com/IamSBStakumi/mysterio_backend/internal/service"
 	"github.com/labstack/echo/v4"
 )
 
 type Server struct{
-	SessionS *service.SessionService
+	SessionS  *service.SessionService
+	ScenarioS *service.ScenarioService
+	Auth      *auth.Signer
+	Operator  *auth.Operator
 }
 
 type ServerInterface interface {
+	// Resolve a join code to its session
+	// (GET /join/{code})
+	GetJoinCode(ctx echo.Context, code string) error
+	// Recent scenario generation attempts
+	// (GET /scenario-generations)
+	GetScenarioGenerations(ctx echo.Context) error
+	// List saved scenarios
+	// (GET /scenarios)
+	GetScenarios(ctx echo.Context) error
+	// Upload a hand-written scenario
+	// (POST /scenarios)
+	PostScenarios(ctx echo.Context) error
+	// Get a saved scenario
+	// (GET /scenarios/{scenarioId})
+	GetScenario(ctx echo.Context, scenarioId string) error
 	// Create a new game session
 	// (POST /sessions)
 	PostSessions(ctx echo.Context) error
+	// Get the lobby view of a session
+	// (GET /sessions/{sessionId})
+	GetSession(ctx echo.Context, sessionId string) error
+	// Mark the calling player as ready
+	// (POST /sessions/{sessionId}/ready)
+	PostSessionReady(ctx echo.Context, sessionId string) error
+	// Close the lobby and start the game
+	// (POST /sessions/{sessionId}/start)
+	PostSessionStart(ctx echo.Context, sessionId string) error
+	// Stream session events
+	// (GET /sessions/{sessionId}/events)
+	GetSessionEvents(ctx echo.Context, sessionId string, params api.GetSessionEventsParams) error
+	// Open the table chat WebSocket
+	// (GET /sessions/{sessionId}/chat)
+	GetSessionChat(ctx echo.Context, sessionId string, params api.GetSessionChatParams) error
+	// Choose the phases in which whispers are allowed
+	// (PUT /sessions/{sessionId}/chat/whisper-phases)
+	PutSessionWhisperPhases(ctx echo.Context, sessionId string) error
+	// Accuse a role in the voting phase
+	// (POST /sessions/{sessionId}/votes)
+	PostSessionVotes(ctx echo.Context, sessionId string) error
+	// Break a tied vote
+	// (POST /sessions/{sessionId}/votes/decision)
+	PostSessionVoteDecision(ctx echo.Context, sessionId string) error
+	// Get the result of the closed vote
+	// (GET /sessions/{sessionId}/votes/result)
+	GetSessionVoteResult(ctx echo.Context, sessionId string) error
+	// Reveal the truth and each player's outcome
+	// (GET /sessions/{sessionId}/ending)
+	GetSessionEnding(ctx echo.Context, sessionId string) error
+	// Judge a personal goal (GM use)
+	// (POST /sessions/{sessionId}/ending/goals)
+	PostSessionEndingGoals(ctx echo.Context, sessionId string) error
+	// Investigate a location or character
+	// (POST /sessions/{sessionId}/investigations)
+	PostSessionInvestigations(ctx echo.Context, sessionId string) error
+	// List the clue cards the caller can read
+	// (GET /sessions/{sessionId}/clues)
+	GetSessionClues(ctx echo.Context, sessionId string) error
+	// Reveal a held clue card to everyone
+	// (POST /sessions/{sessionId}/clues/{clueId}/reveal)
+	PostSessionClueReveal(ctx echo.Context, sessionId string, clueId string) error
+	// Offer a held clue card to another player
+	// (POST /sessions/{sessionId}/clues/{clueId}/offers)
+	PostSessionClueOffers(ctx echo.Context, sessionId string, clueId string) error
+	// List clue offers involving the caller
+	// (GET /sessions/{sessionId}/offers)
+	GetSessionOffers(ctx echo.Context, sessionId string) error
+	// Accept a clue offer and take the card
+	// (POST /sessions/{sessionId}/offers/{offerId}/accept)
+	PostSessionOfferAccept(ctx echo.Context, sessionId string, offerId string) error
+	// Decline a clue offer, or withdraw one you made
+	// (POST /sessions/{sessionId}/offers/{offerId}/decline)
+	PostSessionOfferDecline(ctx echo.Context, sessionId string, offerId string) error
+	// Get the timer of the current phase
+	// (GET /sessions/{sessionId}/timer)
+	GetSessionTimer(ctx echo.Context, sessionId string) error
+	// Pause the phase timer
+	// (POST /sessions/{sessionId}/timer/pause)
+	PostSessionTimerPause(ctx echo.Context, sessionId string) error
+	// Resume the paused phase timer
+	// (POST /sessions/{sessionId}/timer/resume)
+	PostSessionTimerResume(ctx echo.Context, sessionId string) error
+	// Add time to the phase timer
+	// (POST /sessions/{sessionId}/timer/extend)
+	PostSessionTimerExtend(ctx echo.Context, sessionId string) error
 	// Advance to the next phase
 	// (POST /sessions/{sessionId}/advance)
 	PostSessionAdvance(ctx echo.Context, sessionId string) error
+	// Go back to the previous phase
+	// (POST /sessions/{sessionId}/rewind)
+	PostSessionRewind(ctx echo.Context, sessionId string) error
+	// List the phase transitions of a session
+	// (GET /sessions/{sessionId}/transitions)
+	GetSessionTransitions(ctx echo.Context, sessionId string) error
+	// Download printable handouts
+	// (GET /sessions/{sessionId}/handouts)
+	GetSessionHandouts(ctx echo.Context, sessionId string) error
+	// Hand the host role over to a player
+	// (POST /sessions/{sessionId}/host)
+	PostSessionHost(ctx echo.Context, sessionId string) error
+	// Deal roles to the joined players
+	// (POST /sessions/{sessionId}/roles)
+	PostSessionRoles(ctx echo.Context, sessionId string) error
 	// Get current phase info
 	// (GET /sessions/{sessionId}/phase)
-	GetSessionPhase(ctx echo.Context, sessionId string, params api.GetSessionPhaseParams) error
+	GetSessionPhase(ctx echo.Context, sessionId string) error
 	// Join Session
 	// (POST /sessions/{sessionId}/players)
 	PostSessionPlayers(ctx echo.Context, sessionId string) error
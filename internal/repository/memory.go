@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// MemoryRepository はプロセス内のmapにセッションを保持する。再起動すると失われる
+// 取得・保存のたびに複製するので、呼び出し側が保存せずに書き換えた内容は残らない
+type MemoryRepository struct {
+	mu            sync.RWMutex
+	sessions      map[string]*domain.Session
+	scenarios     map[string]*domain.LibraryScenario
+	messages      map[string][]domain.ChatMessage
+	lastMessageID int64
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		sessions:  make(map[string]*domain.Session),
+		scenarios: make(map[string]*domain.LibraryScenario),
+		messages:  make(map[string][]domain.ChatMessage),
+	}
+}
+
+func (r *MemoryRepository) Get(ctx context.Context, id string) (*domain.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, domain.ErrSessionNotFound
+	}
+	return session.Clone(), nil
+}
+
+func (r *MemoryRepository) GetByJoinCode(ctx context.Context, code string) (*domain.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, session := range r.sessions {
+		if session.JoinCode == code {
+			return session.Clone(), nil
+		}
+	}
+	return nil, domain.ErrSessionNotFound
+}
+
+func (r *MemoryRepository) Save(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.ID] = session.Clone()
+	return nil
+}
+
+func (r *MemoryRepository) ListTimedSessions(ctx context.Context) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var ids []string
+	for id, session := range r.sessions {
+		if session.Timer != nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *MemoryRepository) AppendMessage(ctx context.Context, message *domain.ChatMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastMessageID++
+	message.ID = r.lastMessageID
+	r.messages[message.SessionID] = append(r.messages[message.SessionID], *message)
+	return nil
+}
+
+func (r *MemoryRepository) ListMessages(ctx context.Context, sessionID string, afterID int64) ([]domain.ChatMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var messages []domain.ChatMessage
+	for _, m := range r.messages[sessionID] {
+		if m.ID > afterID {
+			messages = append(messages, m)
+		}
+	}
+	return messages, nil
+}
+
+func (r *MemoryRepository) GetScenario(ctx context.Context, id string) (*domain.LibraryScenario, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	scenario, ok := r.scenarios[id]
+	if !ok {
+		return nil, domain.ErrScenarioNotFound
+	}
+	copied := *scenario
+	return &copied, nil
+}
+
+func (r *MemoryRepository) ListScenarios(ctx context.Context) ([]*domain.LibraryScenario, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	scenarios := make([]*domain.LibraryScenario, 0, len(r.scenarios))
+	for _, scenario := range r.scenarios {
+		copied := *scenario
+		scenarios = append(scenarios, &copied)
+	}
+	slices.SortFunc(scenarios, func(a, b *domain.LibraryScenario) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return scenarios, nil
+}
+
+func (r *MemoryRepository) SaveScenario(ctx context.Context, scenario *domain.LibraryScenario) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *scenario
+	r.scenarios[scenario.ID] = &copied
+	return nil
+}
+
+func (r *MemoryRepository) IncrementPlayCount(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	scenario, ok := r.scenarios[id]
+	if !ok {
+		return domain.ErrScenarioNotFound
+	}
+	scenario.PlayCount++
+	return nil
+}
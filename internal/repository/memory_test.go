@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// 取得したセッションを書き換えても、保存するまでは保存済みの内容が変わらない
+func TestMemoryRepositoryIsolatesSessions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+
+	session := &domain.Session{
+		ID:       "session_1",
+		JoinCode: "ABCDEF",
+		Phase:    domain.PhaseLobby,
+		Players:  map[string]*domain.Player{"player_1": {ID: "player_1", Name: "a"}},
+		Votes:    map[string]string{},
+	}
+	if err := repo.Save(ctx, session); err != nil {
+		t.Fatal(err)
+	}
+	session.Players["player_2"] = &domain.Player{ID: "player_2"}
+
+	got, err := repo.Get(ctx, "session_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Players["player_1"].Ready = true
+	got.Votes["player_1"] = "p2"
+	got.Phase = domain.PhaseIntro
+
+	again, err := repo.GetByJoinCode(ctx, "ABCDEF")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Players) != 1 || again.Players["player_1"].Ready || len(again.Votes) != 0 || again.Phase != domain.PhaseLobby {
+		t.Errorf("stored session changed without Save: %+v", again)
+	}
+}
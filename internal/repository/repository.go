@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// SessionRepository はセッションの永続化先
+type SessionRepository interface {
+	// Get はセッションを取得する。存在しない場合は domain.ErrSessionNotFound を返す
+	Get(ctx context.Context, id string) (*domain.Session, error)
+	// GetByJoinCode は参加コードからセッションを取得する。存在しない場合は domain.ErrSessionNotFound を返す
+	GetByJoinCode(ctx context.Context, code string) (*domain.Session, error)
+	// Save はセッションとそのプレイヤーをまとめて保存する
+	Save(ctx context.Context, session *domain.Session) error
+	// ListTimedSessions はフェーズタイマーを持つセッションのIDを返す。起動時のタイマー復元に使う
+	ListTimedSessions(ctx context.Context) ([]string, error)
+
+	// AppendMessage はチャットメッセージを保存し、採番した ID を message に設定する
+	AppendMessage(ctx context.Context, message *domain.ChatMessage) error
+	// ListMessages は afterID より後のメッセージを古い順に返す
+	ListMessages(ctx context.Context, sessionID string, afterID int64) ([]domain.ChatMessage, error)
+}
+
+// ScenarioRepository は再利用できるシナリオのライブラリ
+type ScenarioRepository interface {
+	// GetScenario はシナリオを取得する。存在しない場合は domain.ErrScenarioNotFound を返す
+	GetScenario(ctx context.Context, id string) (*domain.LibraryScenario, error)
+	// ListScenarios は保存されたシナリオを新しい順に返す
+	ListScenarios(ctx context.Context) ([]*domain.LibraryScenario, error)
+	SaveScenario(ctx context.Context, scenario *domain.LibraryScenario) error
+	// IncrementPlayCount はシナリオのプレイ回数を1増やす
+	IncrementPlayCount(ctx context.Context, id string) error
+}
+
+// Repository はセッションとシナリオを同じ保存先で扱う
+type Repository interface {
+	SessionRepository
+	ScenarioRepository
+}
@@ -0,0 +1,450 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteRepository はセッションを SQLite に保存する。シナリオはJSONのまま保持する
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepository(path string) (*SQLiteRepository, error) {
+	db, err := sql.Open("sqlite", path+"?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+
+	if err := migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate sqlite: %w", err)
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *SQLiteRepository) Get(ctx context.Context, id string) (*domain.Session, error) {
+	return r.getWhere(ctx, "id", id)
+}
+
+func (r *SQLiteRepository) GetByJoinCode(ctx context.Context, code string) (*domain.Session, error) {
+	return r.getWhere(ctx, "join_code", code)
+}
+
+// getWhere の column は呼び出し側で固定した列名のみを渡すこと
+func (r *SQLiteRepository) getWhere(ctx context.Context, column string, value string) (*domain.Session, error) {
+	var (
+		id            string
+		joinCode      string
+		hostID        string
+		hostVotesJSON string
+		phase         string
+		roleAssign    string
+		whisperJSON   string
+		tieBreak      string
+		votesJSON     string
+		voteRound     int
+		tieDecision   string
+		resultJSON    string
+		cluesJSON     string
+		offersJSON    string
+		settingsJSON  string
+		timerJSON     string
+		historyJSON   string
+		goalsJSON     string
+		scenarioJSON  string
+		scenarioID    string
+	)
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id, join_code, host_id, host_votes, phase, role_assignment, whisper_phases,
+			tie_break, votes, vote_round, tie_decision, vote_result, clues, clue_offers, timer_settings, timer, phase_history, goal_results, scenario, scenario_id
+		FROM sessions WHERE `+column+` = ?`, value,
+	).Scan(&id, &joinCode, &hostID, &hostVotesJSON, &phase, &roleAssign, &whisperJSON,
+		&tieBreak, &votesJSON, &voteRound, &tieDecision, &resultJSON, &cluesJSON, &offersJSON, &settingsJSON, &timerJSON, &historyJSON, &goalsJSON, &scenarioJSON, &scenarioID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to select session: %w", err)
+	}
+
+	var scenario domain.Scenario
+	if err := json.Unmarshal([]byte(scenarioJSON), &scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+
+	hostVotes := make(map[string]string)
+	if err := json.Unmarshal([]byte(hostVotesJSON), &hostVotes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal host votes: %w", err)
+	}
+	var whisperPhases []domain.Phase
+	if err := json.Unmarshal([]byte(whisperJSON), &whisperPhases); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal whisper phases: %w", err)
+	}
+
+	votes := make(map[string]string)
+	if err := json.Unmarshal([]byte(votesJSON), &votes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal votes: %w", err)
+	}
+	var voteResult *domain.VoteResult
+	if err := json.Unmarshal([]byte(resultJSON), &voteResult); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vote result: %w", err)
+	}
+
+	clues := make(map[string]*domain.ClueState)
+	if err := json.Unmarshal([]byte(cluesJSON), &clues); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal clues: %w", err)
+	}
+	offers := make(map[string]*domain.ClueOffer)
+	if err := json.Unmarshal([]byte(offersJSON), &offers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal clue offers: %w", err)
+	}
+	var timerSettings domain.TimerSettings
+	if err := json.Unmarshal([]byte(settingsJSON), &timerSettings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal timer settings: %w", err)
+	}
+	var timer *domain.PhaseTimer
+	if err := json.Unmarshal([]byte(timerJSON), &timer); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal timer: %w", err)
+	}
+	var phaseHistory []domain.Transition
+	if err := json.Unmarshal([]byte(historyJSON), &phaseHistory); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal phase history: %w", err)
+	}
+	goalResults := make(map[string]bool)
+	if err := json.Unmarshal([]byte(goalsJSON), &goalResults); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal goal results: %w", err)
+	}
+
+	session := &domain.Session{
+		ID:             id,
+		JoinCode:       joinCode,
+		HostID:         hostID,
+		Phase:          domain.Phase(phase),
+		Scenario:       &scenario,
+		ScenarioID:     scenarioID,
+		Players:        make(map[string]*domain.Player),
+		HostVotes:      hostVotes,
+		RoleAssignment: domain.RoleAssignment(roleAssign),
+		WhisperPhases:  whisperPhases,
+		TieBreak:       domain.TieBreak(tieBreak),
+		Votes:          votes,
+		VoteRound:      voteRound,
+		TieDecision:    tieDecision,
+		VoteResult:     voteResult,
+		Clues:          clues,
+		ClueOffers:     offers,
+		TimerSettings:  timerSettings,
+		Timer:          timer,
+		PhaseHistory:   phaseHistory,
+		GoalResults:    goalResults,
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, name, role_id, ready, joined_at FROM players WHERE session_id = ?`, id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select players: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var player domain.Player
+		if err := rows.Scan(&player.ID, &player.Name, &player.RoleID, &player.Ready, &player.JoinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		session.Players[player.ID] = &player
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate players: %w", err)
+	}
+
+	return session, nil
+}
+
+func (r *SQLiteRepository) Save(ctx context.Context, session *domain.Session) error {
+	scenarioJSON, err := json.Marshal(session.Scenario)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scenario: %w", err)
+	}
+	hostVotesJSON, err := json.Marshal(session.HostVotes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal host votes: %w", err)
+	}
+	whisperJSON, err := json.Marshal(session.WhisperPhases)
+	if err != nil {
+		return fmt.Errorf("failed to marshal whisper phases: %w", err)
+	}
+	votesJSON, err := json.Marshal(session.Votes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal votes: %w", err)
+	}
+	resultJSON, err := json.Marshal(session.VoteResult)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vote result: %w", err)
+	}
+	cluesJSON, err := json.Marshal(session.Clues)
+	if err != nil {
+		return fmt.Errorf("failed to marshal clues: %w", err)
+	}
+	offersJSON, err := json.Marshal(session.ClueOffers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal clue offers: %w", err)
+	}
+	settingsJSON, err := json.Marshal(session.TimerSettings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal timer settings: %w", err)
+	}
+	timerJSON, err := json.Marshal(session.Timer)
+	if err != nil {
+		return fmt.Errorf("failed to marshal timer: %w", err)
+	}
+	historyJSON, err := json.Marshal(session.PhaseHistory)
+	if err != nil {
+		return fmt.Errorf("failed to marshal phase history: %w", err)
+	}
+	goalsJSON, err := json.Marshal(session.GoalResults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal goal results: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO sessions (
+			id, join_code, host_id, host_votes, phase, role_assignment, whisper_phases,
+			tie_break, votes, vote_round, tie_decision, vote_result, clues, clue_offers,
+			timer_settings, timer, phase_history, goal_results, scenario, scenario_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			join_code = excluded.join_code,
+			host_id = excluded.host_id,
+			host_votes = excluded.host_votes,
+			phase = excluded.phase,
+			role_assignment = excluded.role_assignment,
+			whisper_phases = excluded.whisper_phases,
+			tie_break = excluded.tie_break,
+			votes = excluded.votes,
+			vote_round = excluded.vote_round,
+			tie_decision = excluded.tie_decision,
+			vote_result = excluded.vote_result,
+			clues = excluded.clues,
+			clue_offers = excluded.clue_offers,
+			timer_settings = excluded.timer_settings,
+			timer = excluded.timer,
+			phase_history = excluded.phase_history,
+			goal_results = excluded.goal_results,
+			scenario = excluded.scenario,
+			scenario_id = excluded.scenario_id,
+			updated_at = CURRENT_TIMESTAMP`,
+		session.ID, session.JoinCode, session.HostID, string(hostVotesJSON), string(session.Phase), string(session.RoleAssignment), string(whisperJSON),
+		string(session.TieBreak), string(votesJSON), session.VoteRound, session.TieDecision, string(resultJSON), string(cluesJSON), string(offersJSON),
+		string(settingsJSON), string(timerJSON), string(historyJSON), string(goalsJSON), string(scenarioJSON), session.ScenarioID,
+	); err != nil {
+		return fmt.Errorf("failed to upsert session: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM players WHERE session_id = ?`, session.ID); err != nil {
+		return fmt.Errorf("failed to delete players: %w", err)
+	}
+	for _, player := range session.Players {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO players (id, session_id, name, role_id, ready, joined_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			player.ID, session.ID, player.Name, player.RoleID, player.Ready, player.JoinedAt,
+		); err != nil {
+			return fmt.Errorf("failed to insert player: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit session: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteRepository) ListTimedSessions(ctx context.Context) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id FROM sessions WHERE timer != 'null'`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select timed sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan session id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate timed sessions: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *SQLiteRepository) AppendMessage(ctx context.Context, message *domain.ChatMessage) error {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO chat_messages (session_id, kind, sender_id, sender_role_id, to_role_id, text, phase, sent_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		message.SessionID, string(message.Kind), message.SenderID, message.SenderRoleID,
+		message.ToRoleID, message.Text, string(message.Phase), message.SentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert chat message: %w", err)
+	}
+
+	message.ID, err = res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get chat message id: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteRepository) ListMessages(ctx context.Context, sessionID string, afterID int64) ([]domain.ChatMessage, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, kind, sender_id, sender_role_id, to_role_id, text, phase, sent_at
+		FROM chat_messages WHERE session_id = ? AND id > ? ORDER BY id`,
+		sessionID, afterID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select chat messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []domain.ChatMessage
+	for rows.Next() {
+		message := domain.ChatMessage{SessionID: sessionID}
+		var kind, phase string
+		if err := rows.Scan(
+			&message.ID, &kind, &message.SenderID, &message.SenderRoleID,
+			&message.ToRoleID, &message.Text, &phase, &message.SentAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan chat message: %w", err)
+		}
+		message.Kind = domain.ChatKind(kind)
+		message.Phase = domain.Phase(phase)
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate chat messages: %w", err)
+	}
+	return messages, nil
+}
+
+func (r *SQLiteRepository) GetScenario(ctx context.Context, id string) (*domain.LibraryScenario, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT id, title, player_count, difficulty, source, play_count, created_at, scenario
+		FROM scenarios WHERE id = ?`, id,
+	)
+	scenario, err := scanScenario(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrScenarioNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+func (r *SQLiteRepository) ListScenarios(ctx context.Context) ([]*domain.LibraryScenario, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, title, player_count, difficulty, source, play_count, created_at, scenario
+		FROM scenarios ORDER BY created_at DESC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select scenarios: %w", err)
+	}
+	defer rows.Close()
+
+	scenarios := []*domain.LibraryScenario{}
+	for rows.Next() {
+		scenario, err := scanScenario(rows)
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scenarios: %w", err)
+	}
+	return scenarios, nil
+}
+
+// scanScenario は *sql.Row と *sql.Rows のどちらからも1件読み出す
+func scanScenario(row interface{ Scan(...any) error }) (*domain.LibraryScenario, error) {
+	var (
+		scenario     domain.LibraryScenario
+		source       string
+		scenarioJSON string
+	)
+	if err := row.Scan(
+		&scenario.ID, &scenario.Title, &scenario.PlayerCount, &scenario.Difficulty,
+		&source, &scenario.PlayCount, &scenario.CreatedAt, &scenarioJSON,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan scenario: %w", err)
+	}
+	scenario.Source = domain.ScenarioSource(source)
+
+	if err := json.Unmarshal([]byte(scenarioJSON), &scenario.Scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+	return &scenario, nil
+}
+
+func (r *SQLiteRepository) SaveScenario(ctx context.Context, scenario *domain.LibraryScenario) error {
+	scenarioJSON, err := json.Marshal(scenario.Scenario)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scenario: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO scenarios (id, title, player_count, difficulty, source, play_count, created_at, scenario)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			title = excluded.title,
+			player_count = excluded.player_count,
+			difficulty = excluded.difficulty,
+			source = excluded.source,
+			play_count = excluded.play_count,
+			scenario = excluded.scenario`,
+		scenario.ID, scenario.Title, scenario.PlayerCount, scenario.Difficulty,
+		string(scenario.Source), scenario.PlayCount, scenario.CreatedAt, string(scenarioJSON),
+	); err != nil {
+		return fmt.Errorf("failed to upsert scenario: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLiteRepository) IncrementPlayCount(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE scenarios SET play_count = play_count + 1 WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to update play count: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrScenarioNotFound
+	}
+	return nil
+}
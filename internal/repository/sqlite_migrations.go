@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// sqliteMigration は PRAGMA user_version を1つ上げるスキーマ変更
+type sqliteMigration func(ctx context.Context, tx *sql.Tx) error
+
+// sqliteMigrations[i] を適用すると user_version が i+1 になる
+// 適用済みの手順は書き換えず、変更は末尾に追加すること
+var sqliteMigrations = []sqliteMigration{
+	// 1: セッション、プレイヤー、チャット
+	execStatements(`
+		CREATE TABLE IF NOT EXISTS sessions (
+			id         TEXT PRIMARY KEY,
+			join_code  TEXT NOT NULL UNIQUE,
+			phase      TEXT NOT NULL,
+			scenario   TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`, `
+		CREATE TABLE IF NOT EXISTS players (
+			id         TEXT PRIMARY KEY,
+			session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+			name       TEXT NOT NULL,
+			role_id    TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS players_session_id ON players(session_id)`, `
+		CREATE TABLE IF NOT EXISTS chat_messages (
+			id             INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_id     TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+			kind           TEXT NOT NULL,
+			sender_id      TEXT NOT NULL,
+			sender_role_id TEXT NOT NULL,
+			to_role_id     TEXT NOT NULL,
+			text           TEXT NOT NULL,
+			phase          TEXT NOT NULL,
+			sent_at        TIMESTAMP NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS chat_messages_session_id ON chat_messages(session_id, id)`,
+	),
+	// 2: ホスト、ロール配布、投票、手がかり、タイマー、遷移履歴
+	addColumns("sessions",
+		`host_id         TEXT NOT NULL DEFAULT ''`,
+		`host_votes      TEXT NOT NULL DEFAULT '{}'`,
+		`role_assignment TEXT NOT NULL DEFAULT 'join_order'`,
+		`whisper_phases  TEXT NOT NULL DEFAULT '[]'`,
+		`tie_break       TEXT NOT NULL DEFAULT 'revote'`,
+		`votes           TEXT NOT NULL DEFAULT '{}'`,
+		`vote_round      INTEGER NOT NULL DEFAULT 1`,
+		`tie_decision    TEXT NOT NULL DEFAULT ''`,
+		`vote_result     TEXT NOT NULL DEFAULT 'null'`,
+		`clues           TEXT NOT NULL DEFAULT '{}'`,
+		`clue_offers     TEXT NOT NULL DEFAULT '{}'`,
+		`timer_settings  TEXT NOT NULL DEFAULT '{}'`,
+		`timer           TEXT NOT NULL DEFAULT 'null'`,
+		`phase_history   TEXT NOT NULL DEFAULT '[]'`,
+	),
+	// 3: 準備完了と参加時刻
+	addColumns("players",
+		`ready     BOOLEAN NOT NULL DEFAULT FALSE`,
+		`joined_at TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00'`,
+	),
+	// 4: シナリオライブラリ
+	execStatements(`
+		CREATE TABLE IF NOT EXISTS scenarios (
+			id           TEXT PRIMARY KEY,
+			title        TEXT NOT NULL,
+			player_count INTEGER NOT NULL,
+			difficulty   TEXT NOT NULL,
+			source       TEXT NOT NULL,
+			play_count   INTEGER NOT NULL DEFAULT 0,
+			created_at   TIMESTAMP NOT NULL,
+			scenario     TEXT NOT NULL
+		)`,
+	),
+	// 5: ライブラリのシナリオID
+	addColumns("sessions",
+		`scenario_id TEXT NOT NULL DEFAULT ''`,
+	),
+	// 6: 個人目標の判定
+	addColumns("sessions",
+		`goal_results TEXT NOT NULL DEFAULT '{}'`,
+	),
+	// 7: 参加コードを入れる前のデータベースの参加コード
+	addColumns("sessions",
+		`join_code TEXT NOT NULL DEFAULT ''`,
+	),
+	backfillJoinCodes,
+}
+
+// migrate は未適用のスキーマ変更を1つずつトランザクションで適用する
+func migrate(ctx context.Context, db *sql.DB) error {
+	var version int
+	if err := db.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version > len(sqliteMigrations) {
+		return fmt.Errorf("database schema version %d is newer than this build (%d)", version, len(sqliteMigrations))
+	}
+
+	for i := version; i < len(sqliteMigrations); i++ {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %d: %w", i+1, err)
+		}
+		if err := sqliteMigrations[i](ctx, tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %d: %w", i+1, err)
+		}
+		// PRAGMA はプレースホルダを受け付けない
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`PRAGMA user_version = %d`, i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to set schema version %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// backfillJoinCodes は参加コードの無いセッションにコードを振り、重複しないよう一意インデックスを張る
+func backfillJoinCodes(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `SELECT id, join_code FROM sessions`)
+	if err != nil {
+		return fmt.Errorf("failed to list join codes: %w", err)
+	}
+	used := make(map[string]bool)
+	var missing []string
+	for rows.Next() {
+		var id, code string
+		if err := rows.Scan(&id, &code); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan join code: %w", err)
+		}
+		if code == "" {
+			missing = append(missing, id)
+		} else {
+			used[code] = true
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range missing {
+		code, err := domain.NewJoinCode()
+		for err == nil && used[code] {
+			code, err = domain.NewJoinCode()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to generate join code: %w", err)
+		}
+		used[code] = true
+		if _, err := tx.ExecContext(ctx, `UPDATE sessions SET join_code = ? WHERE id = ?`, code, id); err != nil {
+			return fmt.Errorf("failed to set join code of %s: %w", id, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `CREATE UNIQUE INDEX IF NOT EXISTS sessions_join_code ON sessions(join_code)`)
+	return err
+}
+
+func execStatements(statements ...string) sqliteMigration {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range statements {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// addColumns は列を追加する。バージョン管理を始める前のデータベースには列がすでにあることがあるので、その列は飛ばす
+// columns は "列名 型 制約" の形で書く
+func addColumns(table string, columns ...string) sqliteMigration {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		existing, err := columnNames(ctx, tx, table)
+		if err != nil {
+			return err
+		}
+		for _, column := range columns {
+			name := strings.Fields(column)[0]
+			if existing[name] {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `ALTER TABLE `+table+` ADD COLUMN `+column); err != nil {
+				return fmt.Errorf("failed to add %s.%s: %w", table, name, err)
+			}
+		}
+		return nil
+	}
+}
+
+func columnNames(ctx context.Context, tx *sql.Tx, table string) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	names := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan column of %s: %w", table, err)
+		}
+		names[name] = true
+	}
+	return names, rows.Err()
+}
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// 保存したセッションを、すべての項目そのままに取り出せる
+func TestSQLiteRepositoryRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	repo, err := NewSQLiteRepository(filepath.Join(t.TempDir(), "sessions.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+
+	at := time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC)
+	resolved := at.Add(time.Minute)
+	want := &domain.Session{
+		ID:       "session_1",
+		JoinCode: "ABCDEF",
+		HostID:   "player_1",
+		Phase:    domain.PhaseVoting,
+		Scenario: &domain.Scenario{
+			Meta:    domain.ScenarioMeta{PlayerCount: 4, EstimatedTimeMinutes: 90, Difficulty: "medium"},
+			Setting: domain.Setting{Title: "館の殺人"},
+		},
+		ScenarioID:     "scenario_1",
+		RoleAssignment: domain.RoleAssignmentJoinOrder,
+		Players: map[string]*domain.Player{
+			"player_1": {ID: "player_1", Name: "a", RoleID: "p1", Ready: true, JoinedAt: at},
+			"player_2": {ID: "player_2", Name: "b", RoleID: "p2", JoinedAt: at.Add(time.Second)},
+		},
+		HostVotes:     map[string]string{"player_2": "player_1"},
+		WhisperPhases: []domain.Phase{domain.PhaseInvestigation1},
+		TieBreak:      domain.TieBreakGM,
+		Votes:         map[string]string{"player_1": "p2"},
+		VoteRound:     2,
+		TieDecision:   "p2",
+		VoteResult: &domain.VoteResult{
+			Round:         2,
+			Votes:         map[string]string{"player_1": "p2", "player_2": "p1"},
+			Tally:         map[string]int{"p1": 1, "p2": 1},
+			AccusedRoleID: "p2",
+			Decision:      domain.VoteDecisionGM,
+			CulpritID:     "p2",
+			PlayersWin:    true,
+		},
+		Clues: map[string]*domain.ClueState{
+			"c1": {DrawnBy: "player_1", HolderID: "player_2", Phase: domain.PhaseInvestigation1, Revealed: true, DrawnAt: at,
+				History: []domain.ClueHolding{{PlayerID: "player_1", Since: at}, {PlayerID: "player_2", Since: resolved}}},
+		},
+		ClueOffers: map[string]*domain.ClueOffer{
+			"offer_1": {ID: "offer_1", ClueID: "c1", FromID: "player_1", ToID: "player_2", Status: domain.OfferAccepted, CreatedAt: at, ResolvedAt: &resolved},
+		},
+		TimerSettings: domain.TimerSettings{
+			PhaseDurations: map[domain.Phase]time.Duration{domain.PhaseVoting: 5 * time.Minute},
+			Warnings:       []time.Duration{time.Minute},
+			AutoAdvance:    true,
+		},
+		Timer: &domain.PhaseTimer{Phase: domain.PhaseVoting, Duration: 5 * time.Minute, EndsAt: at.Add(5 * time.Minute), Warned: []time.Duration{}},
+		PhaseHistory: []domain.Transition{
+			{From: domain.PhaseDiscussion, To: domain.PhaseVoting, Kind: domain.TransitionAdvance, ActorID: "player_1", Forced: true, At: at},
+		},
+		GoalResults: map[string]bool{"p1": true, "p2": false},
+	}
+	if err := repo.Save(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.Get(ctx, "session_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 列から読んだ時刻はタイムゾーンが変わりうるので、時刻として比べてからそろえる
+	for id, p := range got.Players {
+		if !p.JoinedAt.Equal(want.Players[id].JoinedAt) {
+			t.Errorf("player %s joined at %v, want %v", id, p.JoinedAt, want.Players[id].JoinedAt)
+		}
+		p.JoinedAt = want.Players[id].JoinedAt
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+	}
+
+	if _, err := repo.GetByJoinCode(ctx, "ABCDEF"); err != nil {
+		t.Errorf("GetByJoinCode: %v", err)
+	}
+	if _, err := repo.Get(ctx, "unknown"); err != domain.ErrSessionNotFound {
+		t.Errorf("err = %v, want %v", err, domain.ErrSessionNotFound)
+	}
+}
+
+// バージョン管理を始める前のデータベースも、足りない列を足して開ける
+func TestSQLiteRepositoryMigratesLegacySchema(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "legacy.db")
+
+	// ホスト引き継ぎとロール配布までを入れた頃のスキーマ
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE sessions (
+			id              TEXT PRIMARY KEY,
+			join_code       TEXT NOT NULL UNIQUE,
+			host_id         TEXT NOT NULL,
+			host_votes      TEXT NOT NULL DEFAULT '{}',
+			phase           TEXT NOT NULL,
+			role_assignment TEXT NOT NULL,
+			scenario        TEXT NOT NULL,
+			updated_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE players (
+			id         TEXT PRIMARY KEY,
+			session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+			name       TEXT NOT NULL,
+			role_id    TEXT NOT NULL
+		)`,
+		`INSERT INTO sessions (id, join_code, host_id, phase, role_assignment, scenario)
+			VALUES ('session_1', 'ABCDEF', 'host_1', 'lobby', 'shuffle', '{"meta":{"playerCount":4}}')`,
+		`INSERT INTO players (id, session_id, name, role_id)
+			VALUES ('player_1', 'session_1', 'a', 'p1')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	repo, err := NewSQLiteRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+
+	var version int
+	if err := repo.db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(sqliteMigrations) {
+		t.Errorf("user_version = %d, want %d", version, len(sqliteMigrations))
+	}
+
+	session, err := repo.Get(ctx, "session_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.HostID != "host_1" || session.RoleAssignment != domain.RoleAssignmentShuffle || session.Scenario.Meta.PlayerCount != 4 {
+		t.Errorf("existing columns not kept: %+v", session)
+	}
+	if session.TieBreak != domain.TieBreakRevote || session.VoteRound != 1 || session.VoteResult != nil || session.Timer != nil {
+		t.Errorf("added columns not defaulted: %+v", session)
+	}
+	if p := session.Players["player_1"]; p == nil || p.Name != "a" || p.Ready || !p.JoinedAt.IsZero() {
+		t.Errorf("player not kept: %+v", session.Players)
+	}
+
+	// 追加した列にも書き込める
+	session.Phase = domain.PhaseIntro
+	session.Votes = map[string]string{"player_1": "p2"}
+	if err := repo.Save(ctx, session); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.ListMessages(ctx, "session_1", 0); err != nil {
+		t.Errorf("chat table missing: %v", err)
+	}
+	if _, err := repo.ListScenarios(ctx); err != nil {
+		t.Errorf("scenario table missing: %v", err)
+	}
+}
+
+// 参加コードを入れる前のデータベースでは、既存のセッションにコードを振る
+func TestSQLiteRepositoryBackfillsJoinCodes(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "legacy.db")
+
+	// 参加コードを入れる前のスキーマ
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE sessions (
+			id         TEXT PRIMARY KEY,
+			phase      TEXT NOT NULL,
+			scenario   TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`INSERT INTO sessions (id, phase, scenario) VALUES ('session_1', 'lobby', '{}')`,
+		`INSERT INTO sessions (id, phase, scenario) VALUES ('session_2', 'intro', '{}')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	repo, err := NewSQLiteRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+
+	codes := make(map[string]string)
+	for _, id := range []string{"session_1", "session_2"} {
+		session, err := repo.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		code := session.JoinCode
+		if len(code) != domain.JOIN_CODE_LENGTH || strings.Trim(code, domain.JOIN_CODE_ALPHABET) != "" {
+			t.Errorf("%s join code = %q", id, code)
+		}
+		if other, ok := codes[code]; ok {
+			t.Errorf("%s and %s share join code %q", id, other, code)
+		}
+		codes[code] = id
+
+		found, err := repo.GetByJoinCode(ctx, code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found.ID != id {
+			t.Errorf("GetByJoinCode(%q) = %s, want %s", code, found.ID, id)
+		}
+	}
+
+	// 振ったコードは一意インデックスで守られる
+	session, err := repo.Get(ctx, "session_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.db.Exec(`INSERT INTO sessions (id, join_code, phase, scenario) VALUES ('session_3', ?, 'lobby', '{}')`, session.JoinCode); err == nil {
+		t.Error("duplicate join code accepted")
+	}
+}
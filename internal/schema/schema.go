@@ -0,0 +1,9 @@
+// Package schema はシナリオのJSONスキーマをバイナリに埋め込む
+package schema
+
+import _ "embed"
+
+// ScenarioJSON は scenario.schema.json の中身。作業ディレクトリによらず使える
+//
+//go:embed scenario.schema.json
+var ScenarioJSON []byte
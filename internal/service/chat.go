@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// PostMessage はチャットメッセージを保存し、読める利用者にだけ配信する
+// 公開チャットはホストと参加者、ささやきはロールを持つプレイヤー同士、アナウンスはホストのみ送れる
+func (s *SessionService) PostMessage(
+	ctx context.Context,
+	sessionID string,
+	senderID string,
+	kind domain.ChatKind,
+	toRoleID string,
+	text string,
+) (*domain.ChatMessage, error) {
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, domain.ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(text) > domain.MAX_CHAT_MESSAGE_LENGTH {
+		return nil, domain.ErrMessageTooLong
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, isPlayer := session.Players[senderID]
+	if !isPlayer && !session.IsHost(senderID) {
+		return nil, domain.ErrNotAllowed
+	}
+
+	message := &domain.ChatMessage{
+		SessionID: sessionID,
+		Kind:      kind,
+		SenderID:  senderID,
+		Text:      text,
+		Phase:     session.Phase,
+		SentAt:    time.Now(),
+	}
+	if isPlayer {
+		message.SenderRoleID = sender.RoleID
+	}
+
+	var recipients []string
+	switch kind {
+	case domain.ChatPublic:
+	case domain.ChatAnnouncement:
+		if !session.IsHost(senderID) {
+			return nil, domain.ErrNotHost
+		}
+	case domain.ChatWhisper:
+		if !isPlayer || sender.RoleID == "" {
+			return nil, domain.ErrNotAllowed
+		}
+		if !session.WhisperAllowed() {
+			return nil, domain.ErrWhisperClosed
+		}
+		target, ok := session.PlayerByRole(toRoleID)
+		if !ok || target.ID == senderID {
+			return nil, domain.ErrUnknownRole
+		}
+		message.ToRoleID = toRoleID
+		recipients = []string{senderID, target.ID}
+	default:
+		return nil, domain.ErrUnknownChatKind
+	}
+
+	if err := s.repo.AppendMessage(ctx, message); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(sessionID, EventChatMessage, message, recipients...)
+	return message, nil
+}
+
+// ChatHistory は afterID より後のメッセージのうち viewerID が読めるものを返す
+func (s *SessionService) ChatHistory(
+	ctx context.Context,
+	sessionID string,
+	viewerID string,
+	afterID int64,
+) ([]domain.ChatMessage, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+
+	messages, err := s.repo.ListMessages(ctx, sessionID, afterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(messages, func(m domain.ChatMessage) bool {
+		return !m.VisibleTo(session, viewerID)
+	}), nil
+}
+
+// SetWhisperPhases はささやきを使えるフェーズをホストが変更する
+func (s *SessionService) SetWhisperPhases(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	phases []domain.Phase,
+) ([]domain.Phase, error) {
+
+	for _, p := range phases {
+		if !p.Valid() {
+			return nil, domain.ErrUnknownPhase
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsHost(actorID) {
+		return nil, domain.ErrNotHost
+	}
+
+	// 進行順にそろえて重複を除く
+	session.WhisperPhases = nil
+	for _, p := range append([]domain.Phase{domain.PhaseLobby}, domain.PhaseOrder...) {
+		if slices.Contains(phases, p) {
+			session.WhisperPhases = append(session.WhisperPhases, p)
+		}
+	}
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	phaseNames := make([]string, 0, len(session.WhisperPhases))
+	for _, p := range session.WhisperPhases {
+		phaseNames = append(phaseNames, string(p))
+	}
+	s.events.Publish(session.ID, EventWhisperPhasesChanged, WhisperPhasesData{Phases: phaseNames})
+	return session.WhisperPhases, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// ClueCard は手がかりの内容と、このセッションでの状態
+type ClueCard struct {
+	domain.Clue
+	State domain.ClueState
+}
+
+// Investigate は行動ポイントを1つ使って対象を調べ、まだ引かれていない手がかりを1枚引く
+func (s *SessionService) Investigate(
+	ctx context.Context,
+	sessionID string,
+	playerID string,
+	targetType domain.ClueTargetType,
+	target string,
+) (*ClueCard, int, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if session.Players[playerID] == nil {
+		return nil, 0, domain.ErrNotAllowed
+	}
+	if !session.InInvestigation() {
+		return nil, 0, domain.ErrNotInvestigating
+	}
+	if session.ActionPointsLeft(playerID) == 0 {
+		return nil, 0, domain.ErrNoActionPoints
+	}
+
+	isTarget := func(t domain.ClueTarget) bool {
+		return t.Type == targetType && t.Target == target
+	}
+	if !slices.ContainsFunc(session.ClueTargets(), isTarget) {
+		return nil, 0, domain.ErrUnknownTarget
+	}
+	clues := session.UndrawnClues(targetType, target)
+	if len(clues) == 0 {
+		return nil, 0, domain.ErrNoCluesLeft
+	}
+
+	clue := clues[rand.IntN(len(clues))]
+	now := time.Now()
+	state := &domain.ClueState{
+		DrawnBy:  playerID,
+		HolderID: playerID,
+		Phase:    session.Phase,
+		DrawnAt:  now,
+		History:  []domain.ClueHolding{{PlayerID: playerID, Since: now}},
+	}
+	session.Clues[clue.ID] = state
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, 0, err
+	}
+
+	s.events.Publish(session.ID, EventInvestigated, InvestigatedData{
+		PlayerID:   playerID,
+		TargetType: string(targetType),
+		Target:     target,
+	})
+	card := clueCardFor(session, clue, state, playerID)
+	return &card, session.ActionPointsLeft(playerID), nil
+}
+
+// ListClues は viewerID の利用者が読める手がかりをシナリオの登場順で返す
+// プレイヤーには手持ちと公開済みのもの、ホストには引かれたもの全てを返す
+func (s *SessionService) ListClues(ctx context.Context, sessionID string, viewerID string) ([]ClueCard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+
+	cards := []ClueCard{}
+	for _, c := range session.Scenario.Clues {
+		state, ok := session.Clues[c.ID]
+		if ok && session.ClueVisibleTo(state, viewerID) {
+			cards = append(cards, clueCardFor(session, c, state, viewerID))
+		}
+	}
+	return cards, nil
+}
+
+// RevealClue は持ち主が手がかりを全員に公開する
+func (s *SessionService) RevealClue(
+	ctx context.Context,
+	sessionID string,
+	playerID string,
+	clueID string,
+) (*ClueCard, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	clue, ok := session.Scenario.Clue(clueID)
+	state := session.Clues[clueID]
+	if !ok || state == nil || !session.ClueVisibleTo(state, playerID) {
+		return nil, domain.ErrClueNotFound
+	}
+	if state.HolderID != playerID {
+		return nil, domain.ErrNotClueHolder
+	}
+
+	if !state.Revealed {
+		state.Revealed = true
+		if err := s.repo.Save(ctx, session); err != nil {
+			return nil, err
+		}
+		s.events.Publish(session.ID, EventClueRevealed, ClueRevealedData{
+			PlayerID: playerID,
+			ClueID:   clue.ID,
+			Title:    clue.Title,
+			Text:     clue.Text,
+		})
+	}
+	card := clueCardFor(session, *clue, state, playerID)
+	return &card, nil
+}
+
+// clueCardFor は viewerID に見せる形で手がかりを返す
+// 受け渡しは当事者とホストにしか知らせないので、持ち主の履歴はホストにだけ見せる
+func clueCardFor(session *domain.Session, clue domain.Clue, state *domain.ClueState, viewerID string) ClueCard {
+	card := ClueCard{Clue: clue, State: *state}
+	if !session.IsHost(viewerID) {
+		card.State.History = nil
+	}
+	return card
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// OfferClue は手持ちの手がかりを他のプレイヤーに渡す申し出をする
+// 1枚の手がかりに同時に出せる申し出は1つだけ
+func (s *SessionService) OfferClue(
+	ctx context.Context,
+	sessionID string,
+	fromID string,
+	clueID string,
+	toID string,
+) (*domain.ClueOffer, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	clue, ok := session.Scenario.Clue(clueID)
+	state := session.Clues[clueID]
+	if !ok || state == nil || !session.ClueVisibleTo(state, fromID) {
+		return nil, domain.ErrClueNotFound
+	}
+	if state.HolderID != fromID {
+		return nil, domain.ErrNotClueHolder
+	}
+	if clue.NonTransferable {
+		return nil, domain.ErrClueNotTransferable
+	}
+	if toID == fromID {
+		return nil, domain.ErrSelfOffer
+	}
+	if session.Players[toID] == nil {
+		return nil, domain.ErrPlayerNotFound
+	}
+	if _, ok := session.PendingOffer(clueID); ok {
+		return nil, domain.ErrOfferPending
+	}
+
+	offer := &domain.ClueOffer{
+		ID:        newOfferID(),
+		ClueID:    clueID,
+		FromID:    fromID,
+		ToID:      toID,
+		Status:    domain.OfferPending,
+		CreatedAt: time.Now(),
+	}
+	session.ClueOffers[offer.ID] = offer
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	s.publishOffer(session, EventClueOffered, offer)
+	return offer, nil
+}
+
+// AnswerOffer は申し出に答える
+// 受け取る側は accept で受け取るか断り、渡す側は断ることで取り下げられる
+func (s *SessionService) AnswerOffer(
+	ctx context.Context,
+	sessionID string,
+	playerID string,
+	offerID string,
+	accept bool,
+) (*domain.ClueOffer, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, ok := session.ClueOffers[offerID]
+	if !ok || !offer.Involves(playerID) {
+		return nil, domain.ErrOfferNotFound
+	}
+	if offer.Status != domain.OfferPending {
+		return nil, domain.ErrOfferClosed
+	}
+
+	now := time.Now()
+	event := EventClueDeclined
+	switch {
+	case accept && playerID == offer.ToID:
+		offer.Status = domain.OfferAccepted
+		session.TransferClue(offer.ClueID, offer.ToID, now)
+		event = EventClueTransferred
+	case accept:
+		return nil, domain.ErrNotAllowed
+	case playerID == offer.ToID:
+		offer.Status = domain.OfferDeclined
+	default:
+		offer.Status = domain.OfferWithdrawn
+	}
+	offer.ResolvedAt = &now
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	s.publishOffer(session, event, offer)
+	return offer, nil
+}
+
+// ListOffers は viewerID が当事者の申し出を新しい順に返す。ホストには全てを返す
+func (s *SessionService) ListOffers(ctx context.Context, sessionID string, viewerID string) ([]domain.ClueOffer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+
+	offers := []domain.ClueOffer{}
+	for _, o := range session.ClueOffers {
+		if session.IsHost(viewerID) || o.Involves(viewerID) {
+			offers = append(offers, *o)
+		}
+	}
+	slices.SortFunc(offers, func(a, b domain.ClueOffer) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return offers, nil
+}
+
+// publishOffer は申し出の複製を配信する。履歴に残るので、後で書き換わる値を渡さない
+func (s *SessionService) publishOffer(session *domain.Session, typ EventType, offer *domain.ClueOffer) {
+	data := *offer
+	if offer.ResolvedAt != nil {
+		at := *offer.ResolvedAt
+		data.ResolvedAt = &at
+	}
+	s.events.Publish(session.ID, typ, data, offer.FromID, offer.ToID, session.HostID)
+}
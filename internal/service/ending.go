@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// Ending はエンディングで明かす真相と結果
+type Ending struct {
+	GmText     string
+	Truth      domain.Truth
+	VoteResult *domain.VoteResult
+	Outcomes   []domain.PlayerOutcome
+}
+
+// GetEnding はエンディングフェーズに入ったセッションの真相と勝敗を返す
+func (s *SessionService) GetEnding(ctx context.Context, sessionID string, viewerID string) (*Ending, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+	if !session.InEnding() {
+		return nil, domain.ErrNotEnding
+	}
+
+	return &Ending{
+		GmText:     session.Scenario.Phases.Ending.GmText,
+		Truth:      session.Scenario.Truth,
+		VoteResult: session.VoteResult,
+		Outcomes:   session.Outcomes(),
+	}, nil
+}
+
+// JudgeGoal はホストがロールの個人目標を達成したかを判定する。エンディングでのみ行える
+func (s *SessionService) JudgeGoal(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	roleID string,
+	achieved bool,
+) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if !session.IsHost(actorID) {
+		return domain.ErrNotHost
+	}
+	if err := session.JudgeGoal(roleID, achieved); err != nil {
+		return err
+	}
+	if err := s.repo.Save(ctx, session); err != nil {
+		return err
+	}
+
+	s.events.Publish(session.ID, EventGoalJudged, GoalJudgedData{RoleID: roleID, Achieved: achieved})
+	return nil
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// 再接続時に Last-Event-ID から再送できるよう保持するセッションごとの件数
+	EVENT_HISTORY_SIZE = 256
+	// 購読者の受信バッファ。溢れた購読者は切断し、再接続で追いついてもらう
+	EVENT_BUFFER_SIZE = 64
+	// 配信も購読もこの時間なかったセッションは、履歴を捨てて購読者を切断する
+	EVENT_SESSION_TTL = 6 * time.Hour
+	// 期限切れのセッションを探す間隔
+	EVENT_SWEEP_INTERVAL = time.Minute
+)
+
+type EventType string
+
+const (
+	EventPlayerJoined EventType = "player_joined"
+	EventPlayerReady  EventType = "player_ready"
+	EventRoleDealt    EventType = "role_dealt"
+	EventHostChanged  EventType = "host_changed"
+	EventPhaseChanged EventType = "phase_changed"
+	EventChatMessage  EventType = "chat_message"
+	EventVoteCast     EventType = "vote_cast"
+	EventVoteTied     EventType = "vote_tied"
+	EventInvestigated EventType = "investigated"
+	EventClueRevealed EventType = "clue_revealed"
+	// 受け渡しのイベントは当事者2人とホストにだけ届く
+	EventClueOffered     EventType = "clue_offered"
+	EventClueTransferred EventType = "clue_transferred"
+	EventClueDeclined    EventType = "clue_declined"
+	EventTimerUpdated    EventType = "timer_updated"
+	EventTimerWarning    EventType = "timer_warning"
+	EventGoalJudged      EventType = "goal_judged"
+	// ささやきを使えるフェーズの変更は全員に届く
+	EventWhisperPhasesChanged EventType = "whisper_phases_changed"
+	// 裁定は締め切るまで票の内訳と同じく伏せるので、ホストにだけ届く
+	EventTieDecided EventType = "tie_decided"
+)
+
+// Event はセッション内で起きた出来事。Recipients が空なら参加者全員に届く
+type Event struct {
+	ID         int64
+	SessionID  string
+	Type       EventType
+	Data       any
+	Recipients []string
+	At         time.Time
+}
+
+func (e Event) VisibleTo(subscriberID string) bool {
+	return len(e.Recipients) == 0 || slices.Contains(e.Recipients, subscriberID)
+}
+
+type PlayerJoinedData struct {
+	PlayerID   string `json:"playerId"`
+	PlayerName string `json:"playerName"`
+}
+
+type PlayerReadyData struct {
+	PlayerID string `json:"playerId"`
+	Ready    bool   `json:"ready"`
+}
+
+type RoleDealtData struct {
+	RoleID string `json:"roleId"`
+}
+
+type HostChangedData struct {
+	HostID string `json:"hostId"`
+}
+
+type PhaseChangedData struct {
+	Phase string `json:"phase"`
+	// 巻き戻しによる変更なら true
+	Rewind bool `json:"rewind,omitempty"`
+}
+
+// VoteCastData は誰が投票したかだけを伝え、告発先は締め切るまで伏せる
+type VoteCastData struct {
+	PlayerID string `json:"playerId"`
+	Voted    int    `json:"voted"`
+	Voters   int    `json:"voters"`
+}
+
+type VoteTiedData struct {
+	Round   int      `json:"round"`
+	RoleIDs []string `json:"roleIds"`
+}
+
+// InvestigatedData は誰がどこを調べたかだけを伝え、引いた手がかりは伏せる
+type InvestigatedData struct {
+	PlayerID   string `json:"playerId"`
+	TargetType string `json:"targetType"`
+	Target     string `json:"target"`
+}
+
+type ClueRevealedData struct {
+	PlayerID string `json:"playerId"`
+	ClueID   string `json:"clueId"`
+	Title    string `json:"title"`
+	Text     string `json:"text"`
+}
+
+// TimerData はタイマーの一時停止・再開・延長・時間切れを伝える
+type TimerData struct {
+	Phase            string     `json:"phase"`
+	State            string     `json:"state"`
+	RemainingSeconds int        `json:"remainingSeconds"`
+	EndsAt           *time.Time `json:"endsAt,omitempty"`
+}
+
+type TimerWarningData struct {
+	Phase            string `json:"phase"`
+	RemainingSeconds int    `json:"remainingSeconds"`
+}
+
+type TieDecidedData struct {
+	RoleID string `json:"roleId"`
+}
+
+type WhisperPhasesData struct {
+	Phases []string `json:"phases"`
+}
+
+type GoalJudgedData struct {
+	RoleID   string `json:"roleId"`
+	Achieved bool   `json:"achieved"`
+}
+
+type subscription struct {
+	subscriberID string
+	ch           chan Event
+}
+
+// EventHub はセッションごとのイベントを購読者に配信する
+// 履歴はメモリにだけ持つので、再起動をまたいだ再送はできない
+type EventHub struct {
+	mu          sync.Mutex
+	lastID      int64
+	history     map[string][]Event
+	subscribers map[string]map[*subscription]struct{}
+	// セッションごとに最後に配信または購読された時刻
+	active    map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewEventHub() *EventHub {
+	return &EventHub{
+		// 再起動後もIDが巻き戻らないよう時刻から始める
+		lastID:      time.Now().UnixMicro(),
+		history:     make(map[string][]Event),
+		subscribers: make(map[string]map[*subscription]struct{}),
+		active:      make(map[string]time.Time),
+		now:         time.Now,
+	}
+}
+
+// Publish はイベントを記録し、見る権限のある購読者に配信する
+func (h *EventHub) Publish(sessionID string, typ EventType, data any, recipients ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.sweep(now)
+	h.active[sessionID] = now
+
+	h.lastID++
+	event := Event{
+		ID:         h.lastID,
+		SessionID:  sessionID,
+		Type:       typ,
+		Data:       data,
+		Recipients: recipients,
+		At:         now,
+	}
+
+	history := append(h.history[sessionID], event)
+	if len(history) > EVENT_HISTORY_SIZE {
+		history = history[len(history)-EVENT_HISTORY_SIZE:]
+	}
+	h.history[sessionID] = history
+
+	for sub := range h.subscribers[sessionID] {
+		if !event.VisibleTo(sub.subscriberID) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			h.unsubscribe(sessionID, sub)
+		}
+	}
+}
+
+// Subscribe は lastEventID より後のイベントを replay として返し、以降のイベントを ch に流す
+// ch が閉じられたら購読は終了している。不要になったら cancel を呼ぶこと
+func (h *EventHub) Subscribe(sessionID string, subscriberID string, lastEventID int64) (replay []Event, ch <-chan Event, cancel func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.sweep(now)
+	h.active[sessionID] = now
+
+	for _, event := range h.history[sessionID] {
+		if event.ID > lastEventID && event.VisibleTo(subscriberID) {
+			replay = append(replay, event)
+		}
+	}
+
+	sub := &subscription{
+		subscriberID: subscriberID,
+		ch:           make(chan Event, EVENT_BUFFER_SIZE),
+	}
+	if h.subscribers[sessionID] == nil {
+		h.subscribers[sessionID] = make(map[*subscription]struct{})
+	}
+	h.subscribers[sessionID][sub] = struct{}{}
+
+	return replay, sub.ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.unsubscribe(sessionID, sub)
+	}
+}
+
+// sweep は EVENT_SESSION_TTL を過ぎたセッションの履歴と購読者を捨てる
+// エンディング後も結果を見返せるよう、終わったセッションもすぐには捨てない。h.mu を保持して呼ぶこと
+func (h *EventHub) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < EVENT_SWEEP_INTERVAL {
+		return
+	}
+	h.lastSweep = now
+
+	for sessionID, at := range h.active {
+		if now.Sub(at) < EVENT_SESSION_TTL {
+			continue
+		}
+		for sub := range h.subscribers[sessionID] {
+			h.unsubscribe(sessionID, sub)
+		}
+		delete(h.history, sessionID)
+		delete(h.active, sessionID)
+	}
+}
+
+// unsubscribe は h.mu を保持して呼ぶこと
+func (h *EventHub) unsubscribe(sessionID string, sub *subscription) {
+	subs, ok := h.subscribers[sessionID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.ch)
+	if len(subs) == 0 {
+		delete(h.subscribers, sessionID)
+	}
+}
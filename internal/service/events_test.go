@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+func TestEventHubDropsIdleSessions(t *testing.T) {
+	h := NewEventHub()
+	now := time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	h.Publish("idle", EventPhaseChanged, PhaseChangedData{Phase: "ending"})
+	_, idleCh, cancelIdle := h.Subscribe("idle", "player_1", 0)
+	defer cancelIdle()
+
+	now = now.Add(EVENT_SESSION_TTL - time.Minute)
+	h.Publish("busy", EventPhaseChanged, PhaseChangedData{Phase: "intro"})
+
+	// busy が配信し続けている間に idle は期限を過ぎる
+	now = now.Add(2 * time.Minute)
+	h.Publish("busy", EventPhaseChanged, PhaseChangedData{Phase: "investigation1"})
+
+	if _, ok := <-idleCh; ok {
+		t.Error("subscriber of the idle session was not closed")
+	}
+	replay, _, cancel := h.Subscribe("idle", "player_1", 0)
+	cancel()
+	if len(replay) != 0 {
+		t.Errorf("idle session replayed %d events", len(replay))
+	}
+
+	replay, _, cancel = h.Subscribe("busy", "player_1", 0)
+	defer cancel()
+	if len(replay) != 2 {
+		t.Errorf("busy session replayed %d events, want 2", len(replay))
+	}
+}
+
+// lastEvent は subscriberID に見えるイベントのうち最後のものを返す
+func lastEvent(t *testing.T, s *SessionService, sessionID string, subscriberID string) Event {
+	t.Helper()
+	replay, _, cancel, err := s.SubscribeEvents(context.Background(), sessionID, subscriberID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if len(replay) == 0 {
+		t.Fatal("no events")
+	}
+	return replay[len(replay)-1]
+}
+
+func TestSetWhisperPhasesPublishesEvent(t *testing.T) {
+	s, session := startedSession(t, 4)
+	playerID := slices.Collect(maps.Keys(session.Players))[0]
+
+	if _, err := s.SetWhisperPhases(context.Background(), session.ID, session.HostID,
+		[]domain.Phase{domain.PhaseDiscussion, domain.PhaseInvestigation1}); err != nil {
+		t.Fatal(err)
+	}
+
+	event := lastEvent(t, s, session.ID, playerID)
+	data, ok := event.Data.(WhisperPhasesData)
+	if event.Type != EventWhisperPhasesChanged || !ok ||
+		!slices.Equal(data.Phases, []string{"investigation1", "discussion"}) {
+		t.Errorf("event = %+v, want %s with phases in order", event, EventWhisperPhasesChanged)
+	}
+}
+
+func TestDecideTiePublishesEventToHost(t *testing.T) {
+	ctx := context.Background()
+	s, session := startedSession(t, 4)
+
+	// p1 と p2 に2票ずつ入った投票フェーズにする
+	session.Phase = domain.PhaseVoting
+	session.TieBreak = domain.TieBreakGM
+	accused := []string{"p1", "p1", "p2", "p2"}
+	var playerIDs []string
+	for i, id := range slices.Sorted(maps.Keys(session.Players)) {
+		session.Votes[id] = accused[i]
+		playerIDs = append(playerIDs, id)
+	}
+	if err := s.repo.Save(ctx, session); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.DecideTie(ctx, session.ID, session.HostID, "p2"); err != nil {
+		t.Fatal(err)
+	}
+
+	event := lastEvent(t, s, session.ID, session.HostID)
+	if data, ok := event.Data.(TieDecidedData); event.Type != EventTieDecided || !ok || data.RoleID != "p2" {
+		t.Errorf("host event = %+v, want %s for p2", event, EventTieDecided)
+	}
+	// 締め切るまでプレイヤーには裁定を見せない
+	if event := lastEvent(t, s, session.ID, playerIDs[0]); event.Type == EventTieDecided {
+		t.Errorf("player received %s", EventTieDecided)
+	}
+}
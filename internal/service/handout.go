@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// HandoutScenario は配布資料を作るためのシナリオを返す
+// 全ロールの秘密と真相を含むのでホストのみ取得できる
+func (s *SessionService) HandoutScenario(ctx context.Context, sessionID string, actorID string) (*domain.Scenario, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(actorID) {
+		return nil, domain.ErrNotHost
+	}
+	return session.Scenario, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func newSessionID() string {
+	return "session_" + uuid.NewString()
+}
+
+func newHostID() string {
+	return "host_" + uuid.NewString()
+}
+
+func newPlayerID() string {
+	return "player_" + uuid.NewString()
+}
+
+func newOfferID() string {
+	return "offer_" + uuid.NewString()
+}
+
+func newScenarioID() string {
+	return "scenario_" + uuid.NewString()
+}
+
+// NormalizeJoinCode は手入力された参加コードを比較可能な形にそろえる
+func NormalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -3,103 +3,280 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
+	"github.com/IamSBStakumi/mysterio_backend/internal/repository"
 	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 type ScenarioService struct {
 	Schema *jsonschema.Schema
+	// スキーマ違反時に生成をやり直す最大回数
+	MaxRepairAttempts int
+
+	generator generator.ScenarioGenerator
+	library   repository.ScenarioRepository
+
+	mu      sync.Mutex
+	history []GenerationRecord
+}
+
+// GenerationAttempt は1回の生成試行とその違反内容
+type GenerationAttempt struct {
+	Attempt    int
+	Violations []generator.Violation
+	Err        string
 }
 
+// GenerationRecord は1回の Generate 呼び出しで行われた試行の記録
+type GenerationRecord struct {
+	StartedAt time.Time
+	Attempts  []GenerationAttempt
+	Succeeded bool
+}
+
+var messagePrinter = message.NewPrinter(language.English)
+
 const (
-	// SCHEMA_PATH = "internal/schema/scenario.schema.json"
-	SCHEMA_PATH = "internal/schema/scenario.mvp.json"
+	SCHEMA_PATH = "internal/schema/scenario.schema.json"
+
+	DEFAULT_MAX_REPAIR_ATTEMPTS = 2
+	MAX_GENERATION_HISTORY      = 100
 )
 
-func NewScenarioService() (*ScenarioService, error) {
-	compiler := jsonschema.NewCompiler()
+func NewScenarioService(gen generator.ScenarioGenerator, library repository.ScenarioRepository) (*ScenarioService, error) {
+	schema, err := LoadScenarioSchema(SCHEMA_PATH)
+	if err != nil {
+		return nil, err
+	}
 
-	schemaBytes, err := os.ReadFile(SCHEMA_PATH)
+	return &ScenarioService{
+		Schema:            schema,
+		MaxRepairAttempts: DEFAULT_MAX_REPAIR_ATTEMPTS,
+		generator:         gen,
+		library:           library,
+	}, nil
+}
+
+// LoadScenarioSchema はシナリオのJSON Schemaを読み込んでコンパイルする
+func LoadScenarioSchema(path string) (*jsonschema.Schema, error) {
+	schemaBytes, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read schema file: %w", err)
 	}
+	return CompileScenarioSchema(path, schemaBytes)
+}
+
+// CompileScenarioSchema はスキーマの中身をコンパイルする。path はエラーメッセージでの識別に使う
+func CompileScenarioSchema(path string, schemaBytes []byte) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
 
 	var schemaJSON any
 	if err := json.Unmarshal(schemaBytes, &schemaJSON); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
 	}
 
-	if err := compiler.AddResource(SCHEMA_PATH, schemaJSON); err != nil {
+	if err := compiler.AddResource(path, schemaJSON); err != nil {
 		return nil, fmt.Errorf("failed to add schema resource: %w", err)
 	}
 
-	schema, err := compiler.Compile(SCHEMA_PATH)
+	schema, err := compiler.Compile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compile schema: %w", err)
 	}
-
-	return &ScenarioService{Schema: schema}, nil
+	return schema, nil
 }
 
 func (s *ScenarioService) Generate(
 	ctx context.Context,
-	playerCount int,
-	difficulty string,
+	req generator.Request,
 ) (*domain.Scenario, error) {
 
-	// 仮の生成結果。後でAIに差し替える
-	scenarioJSON := []byte(`{
-		"meta": {
-			"title": "Dummy Mystery",
-			"durationMinutes": 90,
-			"playerCount": 5
-		},
-		"roles": [
-			{
-				"id": "p1",
-				"name": "Detective",
-				"description": "You are a detective."
-			},
-			{
-				"id": "p2",
-				"name": "Witness",
-				"description": "You saw something important."
-			},
-			{
-				"id": "p3",
-				"name": "Suspect",
-				"description": "You are hiding something."
-			}
-		],
-		"phases": [
-			{
-				"phase": "intro",
-				"public": {
-					"description": "The Story begins."
-				}
-			}
-		]
-	}`)
+	record := GenerationRecord{StartedAt: time.Now()}
+	defer func() { s.record(record) }()
+
+	for attempt := 0; attempt <= s.MaxRepairAttempts; attempt++ {
+		scenarioJSON, err := s.generator.Generate(ctx, req)
+		if err != nil {
+			record.Attempts = append(record.Attempts, GenerationAttempt{Attempt: attempt, Err: err.Error()})
+			log.Printf("scenario attempt=%d generator error: %v", attempt, err)
+			return nil, domain.ErrGenerationFailed
+		}
+
+		scenario, violations, err := s.parse(scenarioJSON)
+		if scenario != nil {
+			violations = append(violations, metaViolations(req, scenario.Meta)...)
+		}
+		record.Attempts = append(record.Attempts, GenerationAttempt{Attempt: attempt, Violations: violations})
+		if err != nil {
+			return nil, err
+		}
+		if len(violations) == 0 {
+			record.Succeeded = true
+			return scenario, nil
+		}
+
+		log.Printf("scenario attempt=%d violations=%d", attempt, len(violations))
+		req.Correction = &generator.Correction{
+			Previous:   scenarioJSON,
+			Violations: violations,
+		}
+	}
+
+	log.Printf("scenario still invalid after %d attempts", s.MaxRepairAttempts+1)
+	return nil, domain.ErrGenerationFailed
+}
+
+// Validate は生成時やアップロード時と同じ検証をかける。Schema だけを設定した ScenarioService でも使える
+func (s *ScenarioService) Validate(scenarioJSON []byte) (*domain.Scenario, []generator.Violation, error) {
+	return s.parse(scenarioJSON)
+}
+
+// metaViolations は生成結果の meta が依頼した内容と食い違っている箇所を返す
+func metaViolations(req generator.Request, meta domain.ScenarioMeta) []generator.Violation {
+	var violations []generator.Violation
+	mismatch := func(field string, want any) {
+		violations = append(violations, generator.Violation{
+			InstancePath: "/meta/" + field,
+			Keyword:      "request_mismatch",
+			Message:      fmt.Sprintf("must be %v as requested", want),
+		})
+	}
+
+	if req.PlayerCount != 0 && meta.PlayerCount != req.PlayerCount {
+		mismatch("playerCount", req.PlayerCount)
+	}
+	if req.Difficulty != "" && meta.Difficulty != req.Difficulty {
+		mismatch("difficulty", req.Difficulty)
+	}
+
+	requested := requestedParams(req)
+	for _, field := range requested.Unmet(meta.GenerationParams) {
+		switch field {
+		case "theme":
+			mismatch(field, requested.Theme)
+		case "era":
+			mismatch(field, requested.Era)
+		case "tone":
+			mismatch(field, requested.Tone)
+		case "contentRestrictions":
+			mismatch(field, fmt.Sprintf("a list containing %v", requested.ContentRestrictions))
+		case "language":
+			mismatch(field, requested.Language)
+		}
+	}
+	return violations
+}
+
+func requestedParams(req generator.Request) domain.GenerationParams {
+	params := domain.GenerationParams{
+		Theme:    req.Theme,
+		Era:      req.Era,
+		Tone:     domain.Tone(req.Tone),
+		Language: req.Language,
+	}
+	for _, r := range req.ContentRestrictions {
+		params.ContentRestrictions = append(params.ContentRestrictions, domain.ContentRestriction(r))
+	}
+	return params
+}
 
+// parse はJSONをスキーマと意味の両面で検証してパースする。修正可能な問題は violations として返す
+func (s *ScenarioService) parse(scenarioJSON []byte) (*domain.Scenario, []generator.Violation, error) {
 	// 1. JSON Unmarshal
 	var raw any
 	if err := json.Unmarshal(scenarioJSON, &raw); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+		return nil, []generator.Violation{{Keyword: "syntax", Message: err.Error()}}, nil
 	}
 
 	// 2. Schema Validation
 	if err := s.Schema.Validate(raw); err != nil {
-		return nil, fmt.Errorf("failed to validate scenario: %w", err)
+		var ve *jsonschema.ValidationError
+		if !errors.As(err, &ve) {
+			return nil, nil, fmt.Errorf("failed to validate scenario: %w", err)
+		}
+		return nil, schemaViolations(ve), nil
 	}
 
 	// 3. struct にパース
 	var scenario domain.Scenario
 	if err := json.Unmarshal(scenarioJSON, &scenario); err != nil {
-		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	// 4. Semantic Validation
+	if issues := ValidateScenarioSemantics(&scenario); len(issues) > 0 {
+		violations := make([]generator.Violation, 0, len(issues))
+		for _, issue := range issues {
+			violations = append(violations, generator.Violation{
+				InstancePath: issue.Path,
+				Keyword:      string(issue.Code),
+				Message:      issue.Message,
+			})
+		}
+		return nil, violations, nil
+	}
+
+	return &scenario, nil, nil
+}
+
+// schemaViolations は ValidationError のツリーを末端の違反の一覧に平坦化する
+func schemaViolations(ve *jsonschema.ValidationError) []generator.Violation {
+	if len(ve.Causes) > 0 {
+		var violations []generator.Violation
+		for _, cause := range ve.Causes {
+			violations = append(violations, schemaViolations(cause)...)
+		}
+		return violations
+	}
+
+	keyword := ""
+	if path := ve.ErrorKind.KeywordPath(); len(path) > 0 {
+		keyword = path[len(path)-1]
+	}
+
+	return []generator.Violation{{
+		InstancePath: jsonPointer(ve.InstanceLocation),
+		Keyword:      keyword,
+		Message:      ve.ErrorKind.LocalizedString(messagePrinter),
+	}}
+}
+
+func jsonPointer(tokens []string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(t))
 	}
+	return b.String()
+}
+
+func (s *ScenarioService) record(r GenerationRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log.Printf("scenario generation succeeded=%t attempts=%d", r.Succeeded, len(r.Attempts))
+
+	s.history = append(s.history, r)
+	if len(s.history) > MAX_GENERATION_HISTORY {
+		s.history = s.history[len(s.history)-MAX_GENERATION_HISTORY:]
+	}
+}
+
+// GenerationHistory は直近の生成記録を古い順に返す
+func (s *ScenarioService) GenerationHistory() []GenerationRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return &scenario, nil
+	return append([]GenerationRecord(nil), s.history...)
 }
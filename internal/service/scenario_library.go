@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
+)
+
+// ScenarioForSession はセッションで遊ぶシナリオを用意する
+// scenarioID があればライブラリから読み込み、無ければ生成してライブラリに保存する
+// ライブラリのシナリオも、人数や難易度、生成パラメータが依頼と合っていなければ使わない
+func (s *ScenarioService) ScenarioForSession(
+	ctx context.Context,
+	scenarioID string,
+	req generator.Request,
+) (*domain.LibraryScenario, error) {
+
+	if scenarioID != "" {
+		entry, err := s.library.GetScenario(ctx, scenarioID)
+		if err != nil {
+			return nil, err
+		}
+		// 生成時と同じく、依頼した内容とシナリオの meta が食い違っていれば断る
+		if violations := metaViolations(req, entry.Scenario.Meta); len(violations) > 0 {
+			details := make([]string, 0, len(violations))
+			for _, v := range violations {
+				details = append(details, fmt.Sprintf("%s: %s", v.InstancePath, v.Message))
+			}
+			return nil, domain.NewError(domain.ErrInvalidRequest, domain.CodeInvalidRequest,
+				fmt.Sprintf("scenario %s does not match the request: %s", entry.ID, strings.Join(details, "; ")))
+		}
+		return entry, nil
+	}
+
+	scenario, err := s.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	entry := domain.NewLibraryScenario(newScenarioID(), scenario, domain.ScenarioSourceGenerated, time.Now())
+	if err := s.library.SaveScenario(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// UploadScenario は手書きのシナリオを生成時と同じ検証にかけてライブラリに保存する
+func (s *ScenarioService) UploadScenario(ctx context.Context, scenarioJSON []byte) (*domain.LibraryScenario, error) {
+	scenario, violations, err := s.parse(scenarioJSON)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, invalidScenarioError(violations)
+	}
+
+	entry := domain.NewLibraryScenario(newScenarioID(), scenario, domain.ScenarioSourceUploaded, time.Now())
+	if err := s.library.SaveScenario(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *ScenarioService) GetScenario(ctx context.Context, id string) (*domain.LibraryScenario, error) {
+	return s.library.GetScenario(ctx, id)
+}
+
+func (s *ScenarioService) ListScenarios(ctx context.Context) ([]*domain.LibraryScenario, error) {
+	return s.library.ListScenarios(ctx)
+}
+
+// RecordPlay はシナリオでセッションが開始されたことを記録する
+func (s *ScenarioService) RecordPlay(ctx context.Context, id string) error {
+	return s.library.IncrementPlayCount(ctx, id)
+}
+
+// invalidScenarioError は違反内容を1つのメッセージにまとめる
+func invalidScenarioError(violations []generator.Violation) error {
+	details := make([]string, 0, len(violations))
+	for _, v := range violations {
+		path := v.InstancePath
+		if path == "" {
+			path = "/"
+		}
+		details = append(details, fmt.Sprintf("%s: %s", path, v.Message))
+	}
+	return domain.NewError(domain.ErrInvalidRequest, domain.CodeInvalidScenario,
+		"scenario is invalid: "+strings.Join(details, "; "))
+}
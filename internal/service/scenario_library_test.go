@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
+	"github.com/IamSBStakumi/mysterio_backend/internal/repository"
+)
+
+func TestCreateSessionReplayMustMatchScenario(t *testing.T) {
+	ctx := context.Background()
+
+	repo := repository.NewMemoryRepository()
+	scenarioS, err := NewScenarioService(generator.NewFixtureGenerator(), repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSessionService(repo, scenarioS)
+
+	first, err := s.CreateSession(ctx, CreateSessionOptions{PlayerCount: 4, Difficulty: "medium"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := first.Scenario.Meta
+
+	tests := []struct {
+		name string
+		opts CreateSessionOptions
+		ok   bool
+	}{
+		{"same request", CreateSessionOptions{PlayerCount: meta.PlayerCount, Difficulty: meta.Difficulty}, true},
+		{"other player count", CreateSessionOptions{PlayerCount: meta.PlayerCount + 1, Difficulty: meta.Difficulty}, false},
+		{"other difficulty", CreateSessionOptions{PlayerCount: meta.PlayerCount, Difficulty: "hard"}, false},
+		{"other tone", CreateSessionOptions{PlayerCount: meta.PlayerCount, Difficulty: meta.Difficulty,
+			Params: domain.GenerationParams{Tone: domain.ToneComedic}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.ScenarioID = first.ScenarioID
+			session, err := s.CreateSession(ctx, tt.opts)
+			if !tt.ok {
+				var domainErr *domain.Error
+				if !errors.As(err, &domainErr) || domainErr.Code != domain.CodeInvalidRequest {
+					t.Fatalf("err = %v, want %s", err, domain.CodeInvalidRequest)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if session.ScenarioID != first.ScenarioID {
+				t.Errorf("scenario = %s, want %s", session.ScenarioID, first.ScenarioID)
+			}
+		})
+	}
+}
+
+// countingGenerator は呼ばれた回数を数え、常に固定の結果を返す
+type countingGenerator struct {
+	calls  int
+	output []byte
+	err    error
+}
+
+func (g *countingGenerator) Generate(ctx context.Context, req generator.Request) ([]byte, error) {
+	g.calls++
+	return g.output, g.err
+}
+
+func TestCreateSessionRejectsUnplayableSizeBeforeGenerating(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		opts CreateSessionOptions
+		want error
+	}{
+		{"too many players", CreateSessionOptions{PlayerCount: 9, Difficulty: "medium"}, domain.ErrInvalidPlayerCount},
+		{"too few players", CreateSessionOptions{PlayerCount: 3, Difficulty: "medium"}, domain.ErrInvalidPlayerCount},
+		{"unknown difficulty", CreateSessionOptions{PlayerCount: 4, Difficulty: "bogus"}, domain.ErrInvalidDifficulty},
+		{"missing difficulty", CreateSessionOptions{PlayerCount: 4}, domain.ErrInvalidDifficulty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := &countingGenerator{err: errors.New("must not be called")}
+			repo := repository.NewMemoryRepository()
+			scenarioS, err := NewScenarioService(gen, repo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := NewSessionService(repo, scenarioS)
+
+			_, err = s.CreateSession(ctx, tt.opts)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if !errors.Is(err, domain.ErrInvalidRequest) {
+				t.Errorf("err = %v, want kind %v", err, domain.ErrInvalidRequest)
+			}
+			if gen.calls != 0 {
+				t.Errorf("generator calls = %d, want 0", gen.calls)
+			}
+		})
+	}
+}
+
+func TestGenerateReturnsTypedErrorOnFailure(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		gen       *countingGenerator
+		wantCalls int
+	}{
+		{"generator error", &countingGenerator{err: errors.New("upstream down")}, 1},
+		{"never valid", &countingGenerator{output: []byte(`{"meta":{}}`)}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioS, err := NewScenarioService(tt.gen, repository.NewMemoryRepository())
+			if err != nil {
+				t.Fatal(err)
+			}
+			scenarioS.MaxRepairAttempts = 2
+
+			_, err = scenarioS.Generate(ctx, generator.Request{PlayerCount: 4, Difficulty: "medium"})
+			var domainErr *domain.Error
+			if !errors.As(err, &domainErr) || domainErr.Code != domain.CodeGenerationFailed {
+				t.Fatalf("err = %v, want %s", err, domain.CodeGenerationFailed)
+			}
+			if !errors.Is(err, domain.ErrUpstream) {
+				t.Errorf("err = %v, want kind %v", err, domain.ErrUpstream)
+			}
+			if tt.gen.calls != tt.wantCalls {
+				t.Errorf("generator calls = %d, want %d", tt.gen.calls, tt.wantCalls)
+			}
+		})
+	}
+}
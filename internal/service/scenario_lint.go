@@ -0,0 +1,195 @@
+package service
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+const (
+	IssueShortText         IssueCode = "short_text"
+	IssueUnreferencedRole  IssueCode = "unreferenced_role"
+	IssueCulpritNamedEarly IssueCode = "culprit_named_early"
+)
+
+// プレイヤーに渡す文章がこれより短いと、手がかりとして読み取れるものが少ない
+const MIN_PLAYER_TEXT_RUNES = 30
+
+// 犯人の名前が出てくると早すぎるフェーズ
+var earlyPhases = []domain.Phase{domain.PhaseIntro, domain.PhaseInvestigation1}
+
+// lintRules は lint のルール。この順に実行する
+var lintRules = []struct {
+	code  IssueCode
+	check func(*domain.Scenario) []Issue
+}{
+	{IssueShortText, lintShortTexts},
+	{IssueUnreferencedRole, lintUnreferencedRoles},
+	{IssueCulpritNamedEarly, lintCulpritNamedEarly},
+}
+
+// LintRules は lint のルール名を実行順に返す
+func LintRules() []IssueCode {
+	codes := make([]IssueCode, 0, len(lintRules))
+	for _, rule := range lintRules {
+		codes = append(codes, rule.code)
+	}
+	return codes
+}
+
+// LintScenario は検証を通ったシナリオについて、遊べはするが直した方がよい点を返す
+// disabled に挙げたルールは実行しない
+func LintScenario(scenario *domain.Scenario, disabled ...IssueCode) []Issue {
+	var issues []Issue
+	for _, rule := range lintRules {
+		if !slices.Contains(disabled, rule.code) {
+			issues = append(issues, rule.check(scenario)...)
+		}
+	}
+	return issues
+}
+
+// playerText はプレイヤーの目に触れる文章1つ
+type playerText struct {
+	path  string
+	phase domain.Phase
+	// 受け取るロール。全員に見える文章では空
+	roleID string
+	text   string
+}
+
+// playerTexts はプレイヤーに渡る文章をシナリオ内の順に並べる。キャラクター設定のフェーズは空
+func playerTexts(scenario *domain.Scenario) []playerText {
+	var texts []playerText
+	for i, c := range scenario.Characters {
+		path := fmt.Sprintf("/characters/%d", i)
+		texts = append(texts,
+			playerText{path: path + "/publicProfile", text: c.PublicProfile},
+			playerText{path: path + "/secret", roleID: c.ID, text: c.Secret},
+			playerText{path: path + "/personalGoal", roleID: c.ID, text: c.PersonalGoal},
+		)
+		for j, k := range c.Knowledge {
+			texts = append(texts, playerText{path: fmt.Sprintf("%s/knowledge/%d/text", path, j), phase: k.Phase, roleID: c.ID, text: k.Text})
+		}
+	}
+	for _, phase := range domain.PhaseOrder {
+		investigation, ok := scenario.Phases.Investigation(phase)
+		if !ok {
+			continue
+		}
+		path := "/phases/" + string(phase)
+		texts = append(texts, playerText{path: path + "/publicInfo", phase: phase, text: investigation.PublicInfo})
+		for _, roleID := range slices.Sorted(maps.Keys(investigation.PrivateInfo)) {
+			texts = append(texts, playerText{path: path + "/privateInfo/" + roleID, phase: phase, roleID: roleID, text: investigation.PrivateInfo[roleID]})
+		}
+	}
+	for i, c := range scenario.Clues {
+		texts = append(texts, playerText{path: fmt.Sprintf("/clues/%d/text", i), phase: c.Phase, text: c.Text})
+	}
+	return texts
+}
+
+// gmTexts は GM が全員に読み上げる文章をフェーズ順に並べる
+// 進行の案内は短くてよいので short_text の対象にはしない
+func gmTexts(scenario *domain.Scenario) []playerText {
+	var texts []playerText
+	for _, phase := range domain.PhaseOrder {
+		text := scenario.Phases.GmText(phase)
+		if text == "" {
+			continue
+		}
+		texts = append(texts, playerText{path: "/phases/" + string(phase) + "/gmText", phase: phase, text: text})
+	}
+	return texts
+}
+
+func lintShortTexts(scenario *domain.Scenario) []Issue {
+	var issues []Issue
+	for _, t := range playerTexts(scenario) {
+		if n := utf8.RuneCountInString(t.text); n < MIN_PLAYER_TEXT_RUNES {
+			issues = append(issues, Issue{
+				Code:    IssueShortText,
+				Path:    t.path,
+				Message: fmt.Sprintf("text is only %d characters long; aim for at least %d", n, MIN_PLAYER_TEXT_RUNES),
+			})
+		}
+	}
+	return issues
+}
+
+// lintUnreferencedRoles は個別情報を1つも受け取らず、手がかりや他のロールの情報にも出てこないロールを探す
+func lintUnreferencedRoles(scenario *domain.Scenario) []Issue {
+	referenced := make(map[string]bool)
+	for _, phase := range domain.PhaseOrder {
+		if investigation, ok := scenario.Phases.Investigation(phase); ok {
+			for roleID := range investigation.PrivateInfo {
+				referenced[roleID] = true
+			}
+		}
+	}
+	for _, c := range scenario.Clues {
+		if c.TargetType == domain.ClueTargetCharacter {
+			referenced[c.Target] = true
+		}
+	}
+	for _, c := range scenario.Characters {
+		for _, k := range c.Knowledge {
+			referenced[k.AboutRoleID] = true
+		}
+	}
+
+	var issues []Issue
+	for i, c := range scenario.Characters {
+		if !referenced[c.ID] {
+			issues = append(issues, Issue{
+				Code:    IssueUnreferencedRole,
+				Path:    fmt.Sprintf("/characters/%d", i),
+				Message: fmt.Sprintf("role %q gets no private info and no clue or other role points at it", c.ID),
+			})
+		}
+	}
+	return issues
+}
+
+// lintCulpritNamedEarly は序盤のヒントや GM の読み上げに犯人の名前が出てくる箇所を探す
+// 犯人自身に渡す文章と、全員に見せるキャラクター紹介は対象外
+func lintCulpritNamedEarly(scenario *domain.Scenario) []Issue {
+	culprit, ok := scenario.Character(scenario.Truth.CulpritID)
+	if !ok {
+		return nil
+	}
+	names := culpritNames(culprit.Name)
+
+	var issues []Issue
+	for _, t := range append(playerTexts(scenario), gmTexts(scenario)...) {
+		if !slices.Contains(earlyPhases, t.phase) || t.roleID == culprit.ID {
+			continue
+		}
+		for _, name := range names {
+			if strings.Contains(t.text, name) {
+				issues = append(issues, Issue{
+					Code:    IssueCulpritNamedEarly,
+					Path:    t.path,
+					Message: fmt.Sprintf("names the culprit %q as early as %s", name, t.phase),
+				})
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// culpritNames は犯人の呼ばれ方として、フルネームと空白で区切った姓・名を返す
+func culpritNames(name string) []string {
+	names := []string{name, strings.Join(strings.Fields(name), "")}
+	for _, part := range strings.Fields(name) {
+		if utf8.RuneCountInString(part) > 1 {
+			names = append(names, part)
+		}
+	}
+	return names
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+func fixtureScenario(t *testing.T) *domain.Scenario {
+	t.Helper()
+	data, err := os.ReadFile("internal/generator/testdata/fixture_scenario.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scenario domain.Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		t.Fatal(err)
+	}
+	return &scenario
+}
+
+func TestLintCulpritNamedEarly(t *testing.T) {
+	if issues := LintScenario(fixtureScenario(t)); len(issues) != 0 {
+		t.Fatalf("fixture has issues: %+v", issues)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*domain.Scenario)
+		path   string
+	}{
+		{"intro knowledge", func(s *domain.Scenario) {
+			s.Characters[0].Knowledge = append(s.Characters[0].Knowledge, domain.Knowledge{
+				AboutRoleID: "p2",
+				Phase:       domain.PhaseIntro,
+				Text:        "事件の夜、佐伯さんが血のついた手袋を庭に埋めているのを見てしまった。",
+			})
+		}, "/characters/0/knowledge/0/text"},
+		{"first investigation private info", func(s *domain.Scenario) {
+			s.Phases.Investigation1.PrivateInfo["p4"] = "昨夜紅茶を運んだのは自分ではなく執事の佐伯だった。盆を渡した時刻は午後十時頃だった。"
+		}, "/phases/investigation1/privateInfo/p4"},
+		{"intro gm text", func(s *domain.Scenario) {
+			s.Phases.Intro.GmText += "執事の佐伯達也が最後に書斎を訪れたことがわかっています。"
+		}, "/phases/intro/gmText"},
+		{"first investigation gm text", func(s *domain.Scenario) {
+			s.Phases.Investigation1.GmText = "第一調査フェーズを開始します。達也の動きに注目してください。"
+		}, "/phases/investigation1/gmText"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenario := fixtureScenario(t)
+			tt.mutate(scenario)
+			issues := LintScenario(scenario)
+			if len(issues) != 1 || issues[0].Code != IssueCulpritNamedEarly || issues[0].Path != tt.path {
+				t.Errorf("issues = %+v, want one %s at %s", issues, IssueCulpritNamedEarly, tt.path)
+			}
+			if issues := LintScenario(scenario, IssueCulpritNamedEarly); len(issues) != 0 {
+				t.Errorf("disabled rule still reported: %+v", issues)
+			}
+		})
+	}
+
+	// 第二調査フェーズで名前が挙がるのは警告しない
+	scenario := fixtureScenario(t)
+	scenario.Phases.Investigation2.GmText += "佐伯の袖口にも注目してください。"
+	if issues := LintScenario(scenario); len(issues) != 0 {
+		t.Errorf("second investigation reported: %+v", issues)
+	}
+}
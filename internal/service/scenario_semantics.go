@@ -0,0 +1,118 @@
+package service
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+type IssueCode string
+
+const (
+	IssueUnknownCulprit         IssueCode = "unknown_culprit"
+	IssueUnknownPrivateInfoRole IssueCode = "unknown_private_info_role"
+	IssueDuplicateRoleID        IssueCode = "duplicate_role_id"
+	IssueNonContiguousRoleID    IssueCode = "non_contiguous_role_id"
+	IssueCharacterCountMismatch IssueCode = "character_count_mismatch"
+	IssueDuplicateClueID        IssueCode = "duplicate_clue_id"
+	IssueUnknownClueTarget      IssueCode = "unknown_clue_target"
+	IssueUnknownKnowledgeRole   IssueCode = "unknown_knowledge_role"
+)
+
+// Issue はJSON Schemaでは検出できないシナリオの矛盾
+type Issue struct {
+	Code    IssueCode `json:"code"`
+	Path    string    `json:"path"`
+	Message string    `json:"message"`
+}
+
+// ValidateScenarioSemantics はキャラクターIDや手がかりIDの参照整合性、人数の一致を検証する
+func ValidateScenarioSemantics(scenario *domain.Scenario) []Issue {
+	var issues []Issue
+
+	roleIDs := make(map[string]bool, len(scenario.Characters))
+	for i, c := range scenario.Characters {
+		path := fmt.Sprintf("/characters/%d/id", i)
+		if roleIDs[c.ID] {
+			issues = append(issues, Issue{
+				Code:    IssueDuplicateRoleID,
+				Path:    path,
+				Message: fmt.Sprintf("role id %q is used more than once", c.ID),
+			})
+		}
+		roleIDs[c.ID] = true
+
+		if want := fmt.Sprintf("p%d", i+1); c.ID != want {
+			issues = append(issues, Issue{
+				Code:    IssueNonContiguousRoleID,
+				Path:    path,
+				Message: fmt.Sprintf("role id %q should be %q", c.ID, want),
+			})
+		}
+	}
+
+	if len(scenario.Characters) != scenario.Meta.PlayerCount {
+		issues = append(issues, Issue{
+			Code:    IssueCharacterCountMismatch,
+			Path:    "/characters",
+			Message: fmt.Sprintf("%d characters for meta.playerCount %d", len(scenario.Characters), scenario.Meta.PlayerCount),
+		})
+	}
+
+	if !roleIDs[scenario.Truth.CulpritID] {
+		issues = append(issues, Issue{
+			Code:    IssueUnknownCulprit,
+			Path:    "/truth/culpritId",
+			Message: fmt.Sprintf("culprit %q is not one of the characters", scenario.Truth.CulpritID),
+		})
+	}
+
+	for _, phase := range []domain.Phase{domain.PhaseInvestigation1, domain.PhaseInvestigation2} {
+		investigation, _ := scenario.Phases.Investigation(phase)
+		for _, roleID := range slices.Sorted(maps.Keys(investigation.PrivateInfo)) {
+			if !roleIDs[roleID] {
+				issues = append(issues, Issue{
+					Code:    IssueUnknownPrivateInfoRole,
+					Path:    jsonPointer([]string{"phases", string(phase), "privateInfo", roleID}),
+					Message: fmt.Sprintf("private info for unknown role %q", roleID),
+				})
+			}
+		}
+	}
+
+	for i, c := range scenario.Characters {
+		for j, k := range c.Knowledge {
+			if !roleIDs[k.AboutRoleID] || k.AboutRoleID == c.ID {
+				issues = append(issues, Issue{
+					Code:    IssueUnknownKnowledgeRole,
+					Path:    fmt.Sprintf("/characters/%d/knowledge/%d/aboutRoleId", i, j),
+					Message: fmt.Sprintf("knowledge of %q is about %q, which is not another character", c.ID, k.AboutRoleID),
+				})
+			}
+		}
+	}
+
+	clueIDs := make(map[string]bool, len(scenario.Clues))
+	for i, c := range scenario.Clues {
+		if clueIDs[c.ID] {
+			issues = append(issues, Issue{
+				Code:    IssueDuplicateClueID,
+				Path:    fmt.Sprintf("/clues/%d/id", i),
+				Message: fmt.Sprintf("clue id %q is used more than once", c.ID),
+			})
+		}
+		clueIDs[c.ID] = true
+
+		if c.TargetType == domain.ClueTargetCharacter && !roleIDs[c.Target] {
+			issues = append(issues, Issue{
+				Code:    IssueUnknownClueTarget,
+				Path:    fmt.Sprintf("/clues/%d/target", i),
+				Message: fmt.Sprintf("clue targets unknown character %q", c.Target),
+			})
+		}
+	}
+
+	return issues
+}
@@ -1,117 +1,608 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
+	"maps"
+	"math/rand/v2"
+	"slices"
 	"sync"
-
-	"fmt"
+	"time"
 
 	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
+	"github.com/IamSBStakumi/mysterio_backend/internal/repository"
 )
 
+const MAX_JOIN_CODE_ATTEMPTS = 10
+
+
 type SessionService struct {
+	// 読み込み→更新→保存を直列化する
 	mu        sync.Mutex
-	sessions  map[string]*domain.Session
+	repo      repository.SessionRepository
 	scenarioS *ScenarioService
+	events    *EventHub
+	// セッションIDごとの次の締め切りのタイマー
+	timers map[string]*time.Timer
 }
 
-func NewSessionService(scenarioS *ScenarioService) *SessionService {
+func NewSessionService(repo repository.SessionRepository, scenarioS *ScenarioService) *SessionService {
 	return &SessionService{
-		sessions:  make(map[string]*domain.Session),
+		repo:      repo,
 		scenarioS: scenarioS,
+		events:    NewEventHub(),
+		timers:    make(map[string]*time.Timer),
 	}
 }
 
-func (s *SessionService) CreateSession(
-	playerCount int,
-	difficulty string,
-) (*domain.Session, error) {
+// CreateSessionOptions はセッション作成時の指定。空の項目は既定値になる
+type CreateSessionOptions struct {
+	PlayerCount    int
+	Difficulty     string
+	RoleAssignment domain.RoleAssignment
+	TieBreak       domain.TieBreak
+	TimerSettings  domain.TimerSettings
+	// ライブラリのシナリオを遊ぶときに指定する。空なら生成する
+	ScenarioID string
+	Params     domain.GenerationParams
+}
 
-	scenario, err := s.scenarioS.Generate(nil, playerCount, difficulty)
+func (s *SessionService) CreateSession(ctx context.Context, opts CreateSessionOptions) (*domain.Session, error) {
+	// 作れない人数や難易度で生成を呼ぶと、やり直しの回数だけ無駄に生成してしまう
+	if err := domain.ValidateScenarioSize(opts.PlayerCount, opts.Difficulty); err != nil {
+		return nil, err
+	}
+	roleAssignment := opts.RoleAssignment
+	if roleAssignment == "" {
+		roleAssignment = domain.RoleAssignmentJoinOrder
+	}
+	if !roleAssignment.Valid() {
+		return nil, domain.NewError(domain.ErrInvalidRequest, domain.CodeInvalidRequest, "unknown role assignment: "+string(roleAssignment))
+	}
+	tieBreak := opts.TieBreak
+	if tieBreak == "" {
+		tieBreak = domain.TieBreakRevote
+	}
+	if !tieBreak.Valid() {
+		return nil, domain.ErrInvalidTieBreak
+	}
+	timerSettings := opts.TimerSettings
+	if err := validateTimerSettings(timerSettings); err != nil {
+		return nil, err
+	}
+	params, err := opts.Params.Normalize()
 	if err != nil {
 		return nil, err
 	}
+	restrictions := make([]string, 0, len(params.ContentRestrictions))
+	for _, r := range params.ContentRestrictions {
+		restrictions = append(restrictions, string(r))
+	}
 
-	session := &domain.Session{
-		ID:       "session_1", // TODO: UUID
-		Phase:    domain.PhaseIntro,
-		Scenario: scenario,
-		Players:  make(map[string]*domain.Player),
+	entry, err := s.scenarioS.ScenarioForSession(ctx, opts.ScenarioID, generator.Request{
+		PlayerCount:         opts.PlayerCount,
+		Difficulty:          opts.Difficulty,
+		Theme:               params.Theme,
+		Era:                 params.Era,
+		Tone:                string(params.Tone),
+		ContentRestrictions: restrictions,
+		Language:            params.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	scenario := entry.Scenario
+
+	// 指定のないフェーズはシナリオの想定プレイ時間から割り振る
+	durations := domain.DefaultPhaseDurations(scenario)
+	maps.Copy(durations, timerSettings.PhaseDurations)
+	timerSettings.PhaseDurations = durations
+	if timerSettings.Warnings == nil {
+		timerSettings.Warnings = slices.Clone(domain.DefaultTimerWarnings)
 	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.sessions[session.ID] = session
 
-	log.Printf("scenario title=%s phaseCount=%d",
-	session.Scenario.Meta.Title,
-	len(session.Scenario.Phases),
+	joinCode, err := s.uniqueJoinCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &domain.Session{
+		ID:             newSessionID(),
+		JoinCode:       joinCode,
+		HostID:         newHostID(),
+		Phase:          domain.PhaseLobby,
+		Scenario:       scenario,
+		ScenarioID:     entry.ID,
+		RoleAssignment: roleAssignment,
+		Players:        make(map[string]*domain.Player),
+		HostVotes:      make(map[string]string),
+		WhisperPhases:  slices.Clone(domain.DefaultWhisperPhases),
+		TieBreak:       tieBreak,
+		Votes:          make(map[string]string),
+		VoteRound:      1,
+		Clues:          make(map[string]*domain.ClueState),
+		ClueOffers:     make(map[string]*domain.ClueOffer),
+		TimerSettings:  timerSettings,
+		GoalResults:    make(map[string]bool),
+	}
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	log.Printf("scenario title=%s characterCount=%d",
+	session.Scenario.Setting.Title,
+	len(session.Scenario.Characters),
 )
 
 	return session, nil
 }
 
+// uniqueJoinCode は既存セッションと衝突しない参加コードを返す。s.mu を保持して呼ぶこと
+func (s *SessionService) uniqueJoinCode(ctx context.Context) (string, error) {
+	for range MAX_JOIN_CODE_ATTEMPTS {
+		code, err := domain.NewJoinCode()
+		if err != nil {
+			return "", fmt.Errorf("failed to generate join code: %w", err)
+		}
+
+		_, err = s.repo.GetByJoinCode(ctx, code)
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return code, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", errors.New("failed to find an unused join code")
+}
+
+// FindByJoinCode は参加コードに対応するセッションを返す
+func (s *SessionService) FindByJoinCode(ctx context.Context, code string) (*domain.Session, error) {
+	return s.repo.GetByJoinCode(ctx, NormalizeJoinCode(code))
+}
+
+// JoinPlayer はプレイヤーを参加させる。ロールが未割り当ての場合 Character は nil
 func (s *SessionService) JoinPlayer(
+	ctx context.Context,
 	sessionID string,
 	playerName string,
-) (*domain.Player, error) {
+) (*domain.Player, *domain.Character, error) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	session, ok := s.sessions[sessionID]
-	if !ok {
-		return nil, errors.New("session not found")
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	playerID := "player_" + playerName // TODO: UUID
-	roleID := "p" + fmt.Sprint(len(session.Players)+1+'0')
+	if !session.InLobby() {
+		return nil, nil, domain.ErrAlreadyStarted
+	}
+	if session.IsFull() {
+		return nil, nil, domain.ErrNoOpenRole
+	}
 
 	player := &domain.Player{
-		ID:     playerID,
-		RoleID: roleID,
+		ID:       newPlayerID(),
+		Name:     playerName,
+		JoinedAt: time.Now(),
+	}
+	// それ以外の方式ではゲーム開始時に DealRoles で割り当てる
+	if session.RoleAssignment == domain.RoleAssignmentJoinOrder {
+		player.RoleID = session.OpenRoles()[0]
+	}
+
+	session.Players[player.ID] = player
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, nil, err
+	}
+
+	s.events.Publish(session.ID, EventPlayerJoined, PlayerJoinedData{
+		PlayerID:   player.ID,
+		PlayerName: player.Name,
+	})
+	role, _ := session.Scenario.Character(player.RoleID)
+	return player, role, nil
+}
+
+// DealRoles は参加者にロールを配る。ホストのみ実行できる
+// shuffle では無作為に、host では assignments (playerID → roleID) の通りに割り当てる
+func (s *SessionService) DealRoles(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	assignments map[string]string,
+) (*domain.Session, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(actorID) {
+		return nil, domain.ErrNotHost
+	}
+	if !session.InLobby() {
+		return nil, domain.ErrRolesLocked
+	}
+
+	switch session.RoleAssignment {
+	case domain.RoleAssignmentShuffle:
+		assignments = shuffledAssignments(session)
+	case domain.RoleAssignmentJoinOrder:
+		return nil, domain.ErrRolesAssignedOnJoin
+	}
+
+	if err := session.AssignRoles(assignments); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	s.publishRoles(session)
+	return session, nil
+}
+
+// publishRoles は各プレイヤーに本人のロールだけを通知する
+func (s *SessionService) publishRoles(session *domain.Session) {
+	for _, playerID := range session.PlayerIDs() {
+		s.events.Publish(session.ID, EventRoleDealt, RoleDealtData{
+			RoleID: session.Players[playerID].RoleID,
+		}, playerID)
+	}
+}
+
+func shuffledAssignments(session *domain.Session) map[string]string {
+	roleIDs := make([]string, 0, len(session.Scenario.Characters))
+	for _, c := range session.Scenario.Characters {
+		roleIDs = append(roleIDs, c.ID)
+	}
+	rand.Shuffle(len(roleIDs), func(i, j int) {
+		roleIDs[i], roleIDs[j] = roleIDs[j], roleIDs[i]
+	})
+
+	assignments := make(map[string]string, len(session.Players))
+	for i, playerID := range session.PlayerIDs() {
+		assignments[playerID] = roleIDs[i]
+	}
+	return assignments
+}
+
+// GetLobby はロビー表示用にセッションを返す。セッションの参加者かホストのみ取得できる
+func (s *SessionService) GetLobby(ctx context.Context, sessionID string, actorID string) (*domain.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(actorID) && session.Players[actorID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+	return session, nil
+}
+
+// SetReady はプレイヤーの準備完了フラグを切り替える
+func (s *SessionService) SetReady(ctx context.Context, sessionID string, playerID string, ready bool) (*domain.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, ok := session.Players[playerID]
+	if !ok {
+		return nil, domain.ErrPlayerNotFound
+	}
+	if !session.InLobby() {
+		return nil, domain.ErrAlreadyStarted
+	}
+
+	player.Ready = ready
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
 	}
 
-	session.Players[playerID] = player
-	return player, nil
+	s.events.Publish(session.ID, EventPlayerReady, PlayerReadyData{
+		PlayerID: player.ID,
+		Ready:    ready,
+	})
+	return session, nil
+}
+
+// StartSession はロビーを締め切ってイントロに進める。shuffle の場合はここでロールを配る
+func (s *SessionService) StartSession(ctx context.Context, sessionID string, actorID string) (*domain.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(actorID) {
+		return nil, domain.ErrNotHost
+	}
+	if err := session.CanStart(); err != nil {
+		return nil, err
+	}
+
+	shuffle := session.RoleAssignment == domain.RoleAssignmentShuffle
+	if shuffle {
+		if err := session.AssignRoles(shuffledAssignments(session)); err != nil {
+			return nil, err
+		}
+	}
+	session.Transit(domain.PhaseIntro, domain.TransitionStart, actorID, false, time.Now())
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	// 開始済みのセッションは巻き戻せないので、記録に失敗してもログに残すだけにする
+	if session.ScenarioID != "" {
+		if err := s.scenarioS.RecordPlay(ctx, session.ScenarioID); err != nil {
+			log.Printf("failed to record play of scenario %s: %v", session.ScenarioID, err)
+		}
+	}
+
+	if shuffle {
+		s.publishRoles(session)
+	}
+	s.events.Publish(session.ID, EventPhaseChanged, PhaseChangedData{Phase: string(session.Phase)})
+	s.scheduleTimer(session)
+	return session, nil
+}
+
+// PhaseView はプレイヤー1人から見た現在フェーズの情報
+type PhaseView struct {
+	Phase       domain.Phase
+	GmText      string
+	PublicInfo  *string
+	PrivateInfo *string
+	// 調査フェーズでのみ設定される
+	ActionPointsLeft *int
+	ClueTargets      []domain.ClueTarget
+	// ゲーム開始後にのみ設定される。本人のロール以外の秘密は含めない
+	Role      *domain.Character
+	Knowledge []domain.Knowledge
 }
 
 func (s *SessionService) GetPhase(
+	ctx context.Context,
 	sessionID string,
 	playerID string,
-) (domain.Phase, error) {
+) (*PhaseView, error) {
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	session, ok := s.sessions[sessionID]
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, ok := session.Players[playerID]
 	if !ok {
-		return "", errors.New("session not found")
+		return nil, domain.ErrPlayerNotFound
 	}
 
-	if _, ok := session.Players[playerID]; !ok {
-		return "", errors.New("player not found")
+	view := &PhaseView{
+		Phase:  session.Phase,
+		GmText: session.Scenario.Phases.GmText(session.Phase),
+	}
+	if investigation, ok := session.Scenario.Phases.Investigation(session.Phase); ok {
+		view.PublicInfo = &investigation.PublicInfo
+		if info, ok := investigation.PrivateInfo[player.RoleID]; ok {
+			view.PrivateInfo = &info
+		}
+		left := session.ActionPointsLeft(player.ID)
+		view.ActionPointsLeft = &left
+		view.ClueTargets = session.ClueTargets()
+	}
+	if session.Phase != domain.PhaseLobby {
+		if role, ok := session.Scenario.Character(player.RoleID); ok {
+			view.Role = role
+			view.Knowledge = role.KnowledgeAt(session.Phase)
+		}
 	}
 
-	return session.Phase, nil
+	return view, nil
 }
 
-func (s *SessionService) AdvancePhase(sessionID string) (domain.Phase, error) {
+// AdvancePhase はホストの操作で次のフェーズへ進める
+// force を付けると、全員の投票がそろっていなくても投票を締め切る
+func (s *SessionService) AdvancePhase(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	force bool,
+) (domain.Phase, error) {
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	session, ok := s.sessions[sessionID]
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if !session.IsHost(actorID) {
+		return "", domain.ErrNotHost
+	}
+	if session.InLobby() {
+		return "", domain.ErrNotStarted
+	}
+
+	return s.advance(ctx, session, domain.TransitionAdvance, actorID, force)
+}
+
+// advance は次のフェーズへ進めて保存する。呼び出し側で s.mu を持っていること
+func (s *SessionService) advance(
+	ctx context.Context,
+	session *domain.Session,
+	kind domain.TransitionKind,
+	actorID string,
+	force bool,
+) (domain.Phase, error) {
+
+	next, ok := session.Phase.Next()
 	if !ok {
-		return "", errors.New("session not found")
+		return "", domain.ErrNoNextPhase
+	}
+	if err := session.CanLeave(force); err != nil {
+		return "", err
 	}
 
-	for i, p := range domain.PhaseOrder {
-		if p == session.Phase && i+1 < len(domain.PhaseOrder) {
-			session.Phase = domain.PhaseOrder[i+1]
-			return session.Phase, nil
+	// 投票フェーズから進めると投票を締め切る。同数で再投票なら投票フェーズに留まる
+	if session.InVoting() {
+		result, err := session.CloseVoting()
+		if err != nil {
+			return "", err
 		}
+		if result == nil {
+			return s.revote(ctx, session)
+		}
+		session.VoteResult = result
+	}
+
+	session.Transit(next, kind, actorID, force, time.Now())
+	if err := s.repo.Save(ctx, session); err != nil {
+		return "", err
 	}
 
+	s.events.Publish(session.ID, EventPhaseChanged, PhaseChangedData{Phase: string(session.Phase)})
+	s.scheduleTimer(session)
 	return session.Phase, nil
 }
+
+// RewindPhase はホストの操作で1つ前のフェーズに戻す。誤って進めたときのやり直し用
+func (s *SessionService) RewindPhase(ctx context.Context, sessionID string, actorID string) (domain.Phase, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if !session.IsHost(actorID) {
+		return "", domain.ErrNotHost
+	}
+	if err := session.Rewind(actorID, time.Now()); err != nil {
+		return "", err
+	}
+	if err := s.repo.Save(ctx, session); err != nil {
+		return "", err
+	}
+
+	s.events.Publish(session.ID, EventPhaseChanged, PhaseChangedData{Phase: string(session.Phase), Rewind: true})
+	s.scheduleTimer(session)
+	return session.Phase, nil
+}
+
+// PhaseHistory はフェーズ遷移の履歴を古い順に返す
+func (s *SessionService) PhaseHistory(ctx context.Context, sessionID string, viewerID string) ([]domain.Transition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+	return session.PhaseHistory, nil
+}
+
+// TransferHost はホスト権限を candidateID のプレイヤーに引き継ぐ
+// ホスト本人なら即座に、プレイヤーからの場合は過半数が同じ候補に投票した時点で引き継ぐ
+func (s *SessionService) TransferHost(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	candidateID string,
+) (string, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := session.Players[candidateID]; !ok {
+		return "", domain.ErrPlayerNotFound
+	}
+
+	previousHostID := session.HostID
+	switch {
+	case session.IsHost(actorID):
+		session.HostID = candidateID
+		clear(session.HostVotes)
+	case session.Players[actorID] != nil:
+		session.HostVotes[actorID] = candidateID
+		votes := 0
+		for _, c := range session.HostVotes {
+			if c == candidateID {
+				votes++
+			}
+		}
+		if votes*2 > len(session.Players) {
+			session.HostID = candidateID
+			clear(session.HostVotes)
+		}
+	default:
+		return "", domain.ErrNotAllowed
+	}
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		return "", err
+	}
+
+	if session.HostID != previousHostID {
+		s.events.Publish(session.ID, EventHostChanged, HostChangedData{HostID: session.HostID})
+	}
+	return session.HostID, nil
+}
+
+// SubscribeEvents はセッションの参加者かホストとしてイベントを購読する
+func (s *SessionService) SubscribeEvents(
+	ctx context.Context,
+	sessionID string,
+	subscriberID string,
+	lastEventID int64,
+) ([]Event, <-chan Event, func(), error) {
+
+	s.mu.Lock()
+	session, err := s.repo.Get(ctx, sessionID)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !session.IsHost(subscriberID) && session.Players[subscriberID] == nil {
+		return nil, nil, nil, domain.ErrNotAllowed
+	}
+
+	replay, ch, cancel := s.events.Subscribe(sessionID, subscriberID, lastEventID)
+	return replay, ch, cancel, nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+	"github.com/IamSBStakumi/mysterio_backend/internal/generator"
+	"github.com/IamSBStakumi/mysterio_backend/internal/repository"
+)
+
+// SCHEMA_PATH はリポジトリのルートからの相対パスなので、ルートで実行する
+func TestMain(m *testing.M) {
+	if err := os.Chdir("../.."); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// startedSession は参加順でロールを配り、イントロまで進めたセッションを返す
+func startedSession(t *testing.T, playerCount int) (*SessionService, *domain.Session) {
+	t.Helper()
+	ctx := context.Background()
+
+	repo := repository.NewMemoryRepository()
+	scenarioS, err := NewScenarioService(generator.NewFixtureGenerator(), repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSessionService(repo, scenarioS)
+
+	// 投票が割れても再投票にならないようにして、エンディングまで進められるようにする
+	session, err := s.CreateSession(ctx, CreateSessionOptions{
+		PlayerCount: playerCount,
+		Difficulty:  "medium",
+		TieBreak:    domain.TieBreakCulpritEscapes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range playerCount {
+		player, _, err := s.JoinPlayer(ctx, session.ID, "player")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.SetReady(ctx, session.ID, player.ID, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	session, err = s.StartSession(ctx, session.ID, session.HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, session
+}
+
+// privateTexts はロールごとに、そのロールだけが受け取るはずの文章を集める
+func privateTexts(scenario *domain.Scenario) map[string][]string {
+	texts := make(map[string][]string)
+	for _, c := range scenario.Characters {
+		texts[c.ID] = append(texts[c.ID], c.Secret, c.PersonalGoal)
+		for _, k := range c.Knowledge {
+			texts[c.ID] = append(texts[c.ID], k.Text)
+		}
+	}
+	for _, phase := range domain.PhaseOrder {
+		if investigation, ok := scenario.Phases.Investigation(phase); ok {
+			for roleID, info := range investigation.PrivateInfo {
+				texts[roleID] = append(texts[roleID], info)
+			}
+		}
+	}
+	return texts
+}
+
+func TestGetPhaseNeverLeaksOtherRoles(t *testing.T) {
+	ctx := context.Background()
+
+	for _, playerCount := range []int{4, 5} {
+		s, session := startedSession(t, playerCount)
+
+		texts := privateTexts(session.Scenario)
+		// 他のロールと共有している文章は、そのロール固有とはいえない
+		unique := func(roleID, text string) bool {
+			for other, others := range texts {
+				if other != roleID && slices.Contains(others, text) {
+					return false
+				}
+			}
+			return true
+		}
+
+		for {
+			for _, playerID := range session.PlayerIDs() {
+				player := session.Players[playerID]
+				view, err := s.GetPhase(ctx, session.ID, playerID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, err := json.Marshal(view)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for roleID, others := range texts {
+					if roleID == player.RoleID {
+						continue
+					}
+					for _, text := range others {
+						if unique(roleID, text) && strings.Contains(string(body), text) {
+							t.Errorf("%d players, phase %s: view for %s contains private text of %s: %q",
+								playerCount, session.Phase, player.RoleID, roleID, text)
+						}
+					}
+				}
+			}
+
+			if session.Phase == domain.PhaseEnding {
+				break
+			}
+			if _, err := s.AdvancePhase(ctx, session.ID, session.HostID, true); err != nil {
+				t.Fatal(err)
+			}
+			var err error
+			if session, err = s.repo.Get(ctx, session.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestGetPhaseReturnsOwnRole(t *testing.T) {
+	ctx := context.Background()
+	s, session := startedSession(t, 5)
+
+	for _, phase := range domain.PhaseOrder {
+		if session.Phase != phase {
+			if _, err := s.AdvancePhase(ctx, session.ID, session.HostID, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for _, player := range session.Players {
+			view, err := s.GetPhase(ctx, session.ID, player.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if view.Phase != phase {
+				t.Fatalf("phase = %s, want %s", view.Phase, phase)
+			}
+			if view.Role == nil || view.Role.ID != player.RoleID {
+				t.Fatalf("phase %s: role of %s not returned", phase, player.RoleID)
+			}
+
+			if investigation, ok := session.Scenario.Phases.Investigation(phase); ok {
+				want, ok := investigation.PrivateInfo[player.RoleID]
+				if ok && (view.PrivateInfo == nil || *view.PrivateInfo != want) {
+					t.Errorf("phase %s: private info of %s not returned", phase, player.RoleID)
+				}
+			} else if view.PrivateInfo != nil {
+				t.Errorf("phase %s: private info returned outside investigation", phase)
+			}
+
+			// まだ明かされていない情報は返さない
+			for _, k := range view.Role.Knowledge {
+				shown := slices.Contains(view.Knowledge, k)
+				if shown != k.Phase.Reached(phase) {
+					t.Errorf("phase %s: knowledge of %s from %s shown = %v", phase, player.RoleID, k.Phase, shown)
+				}
+			}
+		}
+	}
+}
+
+func TestGetPhaseInLobby(t *testing.T) {
+	ctx := context.Background()
+
+	repo := repository.NewMemoryRepository()
+	scenarioS, err := NewScenarioService(generator.NewFixtureGenerator(), repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSessionService(repo, scenarioS)
+	session, err := s.CreateSession(ctx, CreateSessionOptions{PlayerCount: 4, Difficulty: "medium"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	player, _, err := s.JoinPlayer(ctx, session.ID, "player")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	view, err := s.GetPhase(ctx, session.ID, player.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if view.Role != nil || view.Knowledge != nil || view.PrivateInfo != nil {
+		t.Errorf("lobby view carries role data: %+v", view)
+	}
+
+	if _, err := s.GetPhase(ctx, session.ID, "unknown"); err != domain.ErrPlayerNotFound {
+		t.Errorf("err = %v, want %v", err, domain.ErrPlayerNotFound)
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// TimerView は現在のフェーズのタイマーの状態
+type TimerView struct {
+	Phase       domain.Phase
+	State       domain.TimerState
+	Duration    time.Duration
+	Remaining   time.Duration
+	EndsAt      *time.Time
+	AutoAdvance bool
+	Warnings    []time.Duration
+}
+
+// validateTimerSettings はタイマー設定を確かめる。ロビーとエンディングには持ち時間が無いので指定できない
+func validateTimerSettings(settings domain.TimerSettings) error {
+	for phase, d := range settings.PhaseDurations {
+		if !phase.Valid() || phase == domain.PhaseLobby || phase == domain.PhaseEnding || d < 0 {
+			return domain.ErrTimerSettings
+		}
+	}
+	for _, w := range settings.Warnings {
+		if w <= 0 {
+			return domain.ErrTimerSettings
+		}
+	}
+	return nil
+}
+
+func newTimerView(session *domain.Session, now time.Time) *TimerView {
+	view := &TimerView{
+		Phase:       session.Phase,
+		State:       session.Timer.State(),
+		AutoAdvance: session.TimerSettings.AutoAdvance,
+		Warnings:    slices.Clone(session.TimerSettings.Warnings),
+	}
+	if session.Timer != nil {
+		view.Duration = session.Timer.Duration
+		view.Remaining = session.Timer.Remaining(now)
+		if view.State == domain.TimerRunning {
+			endsAt := session.Timer.EndsAt
+			view.EndsAt = &endsAt
+		}
+	}
+	return view
+}
+
+// GetTimer は現在のフェーズのタイマーを返す
+func (s *SessionService) GetTimer(ctx context.Context, sessionID string, viewerID string) (*TimerView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+	return newTimerView(session, time.Now()), nil
+}
+
+func (s *SessionService) PauseTimer(ctx context.Context, sessionID string, actorID string) (*TimerView, error) {
+	return s.controlTimer(ctx, sessionID, actorID, (*domain.PhaseTimer).Pause)
+}
+
+func (s *SessionService) ResumeTimer(ctx context.Context, sessionID string, actorID string) (*TimerView, error) {
+	return s.controlTimer(ctx, sessionID, actorID, (*domain.PhaseTimer).Resume)
+}
+
+// ExtendTimer は持ち時間を延ばす。時間切れの後でも延長して再開できる
+func (s *SessionService) ExtendTimer(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	d time.Duration,
+) (*TimerView, error) {
+
+	if d <= 0 {
+		return nil, domain.ErrTimerSettings
+	}
+	return s.controlTimer(ctx, sessionID, actorID, func(t *domain.PhaseTimer, now time.Time) error {
+		return t.Extend(d, now)
+	})
+}
+
+// controlTimer はホストによるタイマー操作を適用して保存し、締め切りを組み直す
+func (s *SessionService) controlTimer(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	apply func(*domain.PhaseTimer, time.Time) error,
+) (*TimerView, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(actorID) {
+		return nil, domain.ErrNotHost
+	}
+	if session.Timer == nil {
+		return nil, domain.ErrTimerNotRunning
+	}
+
+	now := time.Now()
+	if err := apply(session.Timer, now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	s.publishTimer(session, now)
+	s.scheduleTimer(session)
+	return newTimerView(session, now), nil
+}
+
+// RestoreTimers は保存済みのセッションのタイマーを組み直す。起動時に呼ぶ
+// 停止中に過ぎた締め切りはすぐに処理される
+func (s *SessionService) RestoreTimers(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids, err := s.repo.ListTimedSessions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		session, err := s.repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		s.scheduleTimer(session)
+	}
+	return nil
+}
+
+// scheduleTimer はセッションの次の締め切りに onTimer を仕掛け直す。呼び出し側で s.mu を持っていること
+func (s *SessionService) scheduleTimer(session *domain.Session) {
+	if t, ok := s.timers[session.ID]; ok {
+		t.Stop()
+		delete(s.timers, session.ID)
+	}
+
+	next, ok := session.Timer.NextDeadline(session.TimerSettings.Warnings)
+	if !ok {
+		return
+	}
+	sessionID := session.ID
+	s.timers[sessionID] = time.AfterFunc(time.Until(next), func() {
+		s.onTimer(sessionID)
+	})
+}
+
+// onTimer は締め切りが来たときに警告を出し、時間切れなら必要に応じてフェーズを進める
+// 止め損ねた古いタイマーから呼ばれても、その時点の状態から判断するので害はない
+func (s *SessionService) onTimer(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := context.Background()
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		log.Printf("phase timer: failed to load session %s: %v", sessionID, err)
+		return
+	}
+	timer := session.Timer
+	if timer.State() != domain.TimerRunning {
+		return
+	}
+
+	now := time.Now()
+	if now.Before(timer.EndsAt) {
+		for _, w := range timer.DueWarnings(session.TimerSettings.Warnings, now) {
+			s.events.Publish(session.ID, EventTimerWarning, TimerWarningData{
+				Phase:            string(timer.Phase),
+				RemainingSeconds: int(w / time.Second),
+			})
+		}
+	} else {
+		timer.Expired = true
+	}
+
+	if err := s.repo.Save(ctx, session); err != nil {
+		log.Printf("phase timer: failed to save session %s: %v", sessionID, err)
+		return
+	}
+	s.scheduleTimer(session)
+	if !timer.Expired {
+		return
+	}
+
+	s.publishTimer(session, now)
+	if session.TimerSettings.AutoAdvance {
+		// 投票の同数をホストが裁定していない場合などは進められないので、時間切れのまま待つ
+		if _, err := s.advance(ctx, session, domain.TransitionTimer, "", true); err != nil {
+			log.Printf("phase timer: failed to advance session %s: %v", sessionID, err)
+		}
+	}
+}
+
+func (s *SessionService) publishTimer(session *domain.Session, now time.Time) {
+	data := TimerData{
+		Phase:            string(session.Timer.Phase),
+		State:            string(session.Timer.State()),
+		RemainingSeconds: int(session.Timer.Remaining(now) / time.Second),
+	}
+	// 配信した値は履歴に残るので、タイマーを指さないように複製する
+	if data.State == string(domain.TimerRunning) {
+		endsAt := session.Timer.EndsAt
+		data.EndsAt = &endsAt
+	}
+	s.events.Publish(session.ID, EventTimerUpdated, data)
+}
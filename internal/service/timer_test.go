@@ -0,0 +1,31 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+func TestValidateTimerSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations map[domain.Phase]time.Duration
+		want      error
+	}{
+		{"timed phases", map[domain.Phase]time.Duration{domain.PhaseInvestigation1: 20 * time.Minute, domain.PhaseVoting: 0}, nil},
+		{"lobby", map[domain.Phase]time.Duration{domain.PhaseLobby: 5 * time.Minute}, domain.ErrTimerSettings},
+		{"ending", map[domain.Phase]time.Duration{domain.PhaseEnding: 5 * time.Minute}, domain.ErrTimerSettings},
+		{"unknown phase", map[domain.Phase]time.Duration{"epilogue": 5 * time.Minute}, domain.ErrTimerSettings},
+		{"negative", map[domain.Phase]time.Duration{domain.PhaseDiscussion: -time.Minute}, domain.ErrTimerSettings},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTimerSettings(domain.TimerSettings{PhaseDurations: tt.durations})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/IamSBStakumi/mysterio_backend/internal/domain"
+)
+
+// CastVote はプレイヤーの告発先を記録する。投票フェーズが終わるまで何度でも変えられる
+func (s *SessionService) CastVote(
+	ctx context.Context,
+	sessionID string,
+	playerID string,
+	accusedRoleID string,
+) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.Players[playerID] == nil {
+		return domain.ErrNotAllowed
+	}
+	if !session.InVoting() {
+		return domain.ErrVotingClosed
+	}
+	if _, ok := session.Scenario.Character(accusedRoleID); !ok {
+		return domain.ErrUnknownRole
+	}
+
+	session.Votes[playerID] = accusedRoleID
+	// 票が動けば同数の顔ぶれも変わるので、ホストの裁定はやり直してもらう
+	session.TieDecision = ""
+	if err := s.repo.Save(ctx, session); err != nil {
+		return err
+	}
+
+	s.events.Publish(session.ID, EventVoteCast, VoteCastData{
+		PlayerID: playerID,
+		Voted:    len(session.Votes),
+		Voters:   len(session.Players),
+	})
+	return nil
+}
+
+// DecideTie は同数で並んだロールのうち告発するものをホストが選ぶ
+// 投票を締め切る (フェーズを進める) ときに適用される
+func (s *SessionService) DecideTie(
+	ctx context.Context,
+	sessionID string,
+	actorID string,
+	accusedRoleID string,
+) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if !session.IsHost(actorID) {
+		return domain.ErrNotHost
+	}
+	if !session.InVoting() {
+		return domain.ErrVotingClosed
+	}
+	if session.TieBreak != domain.TieBreakGM {
+		return domain.ErrTieBreakNotGM
+	}
+	_, leaders := session.TallyVotes()
+	if len(leaders) < 2 || !slices.Contains(leaders, accusedRoleID) {
+		return domain.ErrVoteNotTied
+	}
+
+	session.TieDecision = accusedRoleID
+	if err := s.repo.Save(ctx, session); err != nil {
+		return err
+	}
+
+	s.events.Publish(session.ID, EventTieDecided, TieDecidedData{RoleID: accusedRoleID}, session.HostID)
+	return nil
+}
+
+// GetVoteResult は締め切った投票の結果を返す
+func (s *SessionService) GetVoteResult(ctx context.Context, sessionID string, viewerID string) (*domain.VoteResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.repo.Get(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.IsHost(viewerID) && session.Players[viewerID] == nil {
+		return nil, domain.ErrNotAllowed
+	}
+	if session.VoteResult == nil {
+		return nil, domain.ErrVoteResultPending
+	}
+	return session.VoteResult, nil
+}
+
+// revote は同数で割れた票を消して次の投票回を始める
+func (s *SessionService) revote(ctx context.Context, session *domain.Session) (domain.Phase, error) {
+	_, leaders := session.TallyVotes()
+
+	clear(session.Votes)
+	session.VoteRound++
+	session.EnterPhase(session.Phase, time.Now())
+	if err := s.repo.Save(ctx, session); err != nil {
+		return "", err
+	}
+
+	s.events.Publish(session.ID, EventVoteTied, VoteTiedData{
+		Round:   session.VoteRound,
+		RoleIDs: leaders,
+	})
+	s.scheduleTimer(session)
+	return session.Phase, nil
+}